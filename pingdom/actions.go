@@ -0,0 +1,56 @@
+package pingdom
+
+import (
+	"strconv"
+
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+// actionsPageSize is the largest number of alerts the actions API returns at
+// once.
+const actionsPageSize = 300
+
+// alert is a single alert sent to a contact, as returned by /actions.
+type alert struct {
+	ContactName  string `json:"contactname"`
+	ContactID    int    `json:"contactid"`
+	CheckID      int    `json:"checkid"`
+	Time         int64  `json:"time"`
+	Via          string `json:"via"`
+	Status       string `json:"status"`
+	MessageShort string `json:"messageshort"`
+	MessageFull  string `json:"messagefull"`
+	SentTo       string `json:"sentto"`
+	Charged      bool   `json:"charged"`
+}
+
+type actionsJSONResponse struct {
+	Actions struct {
+		Alerts []alert `json:"alerts"`
+	} `json:"actions"`
+}
+
+// listAlerts returns every alert matching the given query parameters, such as
+// from, to, checkids, contactids and status, newest first.
+func listAlerts(client *pingdom.Client, params map[string]string) ([]alert, error) {
+	alerts := []alert{}
+	for offset := 0; ; offset += actionsPageSize {
+		pageParams := map[string]string{
+			"limit":  strconv.Itoa(actionsPageSize),
+			"offset": strconv.Itoa(offset),
+		}
+		for k, v := range params {
+			pageParams[k] = v
+		}
+
+		m := &actionsJSONResponse{}
+		if err := doRequest(client, "GET", "/actions", pageParams, m); err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, m.Actions.Alerts...)
+
+		if len(m.Actions.Alerts) < actionsPageSize {
+			return alerts, nil
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package pingdom
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+// The helpers below talk to the parts of the Pingdom API that go-pingdom does
+// not cover, through the client's own NewRequest, NewJSONRequest and Do.
+
+// doRequest sends a request with the given query parameters and decodes the
+// JSON response into v.
+func doRequest(client *pingdom.Client, method string, rsc string, params map[string]string, v interface{}) error {
+	req, err := client.NewRequest(method, rsc, params)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, v)
+	return err
+}
+
+// doJSONRequest sends body encoded as JSON and decodes the JSON response
+// into v.
+func doJSONRequest(client *pingdom.Client, method string, rsc string, body interface{}, v interface{}) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := client.NewJSONRequest(method, rsc, string(b))
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, v)
+	return err
+}
+
+// isNotFound reports whether err is a Pingdom API error for a missing object.
+func isNotFound(err error) bool {
+	if e, ok := err.(*pingdom.PingdomError); ok {
+		return e.StatusCode == http.StatusNotFound
+	}
+	return false
+}
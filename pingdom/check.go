@@ -0,0 +1,112 @@
+package pingdom
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+// checkDetailsJSONResponse is the raw check details response. The check is
+// decoded twice: once into go-pingdom's CheckResponse and once into
+// checkDetails for the parts go-pingdom does not know about.
+type checkDetailsJSONResponse struct {
+	Check json.RawMessage `json:"check"`
+}
+
+// checkDetails holds the check details that go-pingdom does not decode.
+type checkDetails struct {
+	CustomMessage string           `json:"custom_message,omitempty"`
+	IPv6          bool             `json:"ipv6,omitempty"`
+	Type          checkTypeDetails `json:"type"`
+}
+
+// checkTypeDetails holds the type specific settings of the check types
+// defined in check_types.go.
+type checkTypeDetails struct {
+	DNS  *checkResponseDNSDetails  `json:"dns,omitempty"`
+	UDP  *checkResponseUDPDetails  `json:"udp,omitempty"`
+	SMTP *checkResponseSMTPDetails `json:"smtp,omitempty"`
+	POP3 *checkResponseMailDetails `json:"pop3,omitempty"`
+	IMAP *checkResponseMailDetails `json:"imap,omitempty"`
+
+	HTTPCustom *checkResponseHTTPCustomDetails `json:"httpcustom,omitempty"`
+}
+
+// checkResponseDNSDetails represents the details specific to DNS checks.
+type checkResponseDNSDetails struct {
+	NameServer string `json:"nameserver,omitempty"`
+	ExpectedIP string `json:"expectedip,omitempty"`
+}
+
+// checkResponseUDPDetails represents the details specific to UDP checks.
+type checkResponseUDPDetails struct {
+	Port           int    `json:"port,omitempty"`
+	StringToSend   string `json:"stringtosend,omitempty"`
+	StringToExpect string `json:"stringtoexpect,omitempty"`
+}
+
+// checkResponseSMTPDetails represents the details specific to SMTP checks.
+type checkResponseSMTPDetails struct {
+	Port           int    `json:"port,omitempty"`
+	Auth           string `json:"auth,omitempty"`
+	Encryption     bool   `json:"encryption,omitempty"`
+	StringToExpect string `json:"stringtoexpect,omitempty"`
+}
+
+// checkResponseMailDetails represents the details specific to POP3 and IMAP
+// checks.
+type checkResponseMailDetails struct {
+	Port           int    `json:"port,omitempty"`
+	Encryption     bool   `json:"encryption,omitempty"`
+	StringToExpect string `json:"stringtoexpect,omitempty"`
+}
+
+// checkResponseHTTPCustomDetails represents the details specific to custom
+// HTTP checks.
+type checkResponseHTTPCustomDetails struct {
+	Url            string   `json:"url,omitempty"`
+	Encryption     bool     `json:"encryption,omitempty"`
+	Port           int      `json:"port,omitempty"`
+	Auth           string   `json:"auth,omitempty"`
+	AdditionalUrls []string `json:"additionalurls,omitempty"`
+}
+
+// UnmarshalJSON converts a byte array into a checkTypeDetails. The API may
+// return the type as a plain string, in which case there are no details.
+func (c *checkTypeDetails) UnmarshalJSON(b []byte) error {
+	if len(b) == 0 || b[0] != '{' {
+		return nil
+	}
+
+	type t checkTypeDetails
+	return json.Unmarshal(b, (*t)(c))
+}
+
+// readCheck returns detailed information about a pingdom check given its ID,
+// along with the details go-pingdom's Checks.Read would drop.
+func readCheck(client *pingdom.Client, id int) (*pingdom.CheckResponse, *checkDetails, error) {
+	m := &checkDetailsJSONResponse{}
+	err := doRequest(client, "GET", "/checks/"+strconv.Itoa(id), map[string]string{
+		"include_teams": "true",
+	}, m)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ck := &pingdom.CheckResponse{}
+	if err := json.Unmarshal(m.Check, ck); err != nil {
+		return nil, nil, err
+	}
+	ck.TeamIds = make([]int, len(ck.Teams))
+	for i := range ck.Teams {
+		ck.TeamIds[i] = ck.Teams[i].ID
+	}
+
+	details := &checkDetails{}
+	if err := json.Unmarshal(m.Check, details); err != nil {
+		return nil, nil, err
+	}
+
+	return ck, details, nil
+}
@@ -0,0 +1,535 @@
+package pingdom
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+// The check types below are supported by the Pingdom API but not by
+// go-pingdom, which only ships HTTP, ping and TCP checks. They implement
+// the pingdom.Check interface so they can be passed to client.Checks.
+
+// dnsCheck represents a Pingdom DNS check.
+type dnsCheck struct {
+	Name                     string
+	Hostname                 string
+	Resolution               int
+	Paused                   bool
+	SendNotificationWhenDown int
+	NotifyAgainEvery         int
+	NotifyWhenBackup         bool
+	IntegrationIds           []int
+	Tags                     string
+	ProbeFilters             string
+	UserIds                  []int
+	TeamIds                  []int
+	NameServer               string
+	ExpectedIP               string
+}
+
+// PutParams returns a map of parameters for a dnsCheck that can be sent along
+// with an HTTP PUT request.
+func (ck *dnsCheck) PutParams() map[string]string {
+	m := map[string]string{
+		"name":             ck.Name,
+		"host":             ck.Hostname,
+		"resolution":       strconv.Itoa(ck.Resolution),
+		"paused":           strconv.FormatBool(ck.Paused),
+		"notifyagainevery": strconv.Itoa(ck.NotifyAgainEvery),
+		"notifywhenbackup": strconv.FormatBool(ck.NotifyWhenBackup),
+		"integrationids":   intListToCDString(ck.IntegrationIds),
+		"probe_filters":    ck.ProbeFilters,
+		"tags":             ck.Tags,
+		"userids":          intListToCDString(ck.UserIds),
+		"teamids":          intListToCDString(ck.TeamIds),
+		"nameserver":       ck.NameServer,
+		"expectedip":       ck.ExpectedIP,
+	}
+
+	if ck.SendNotificationWhenDown != 0 {
+		m["sendnotificationwhendown"] = strconv.Itoa(ck.SendNotificationWhenDown)
+	}
+
+	return m
+}
+
+// PostParams returns a map of parameters for a dnsCheck that can be sent along
+// with an HTTP POST request. Same as PUT.
+func (ck *dnsCheck) PostParams() map[string]string {
+	params := ck.PutParams()
+
+	for k, v := range params {
+		if v == "" {
+			delete(params, k)
+		}
+	}
+
+	params["type"] = "dns"
+	return params
+}
+
+// Valid determines whether the dnsCheck contains valid fields.
+func (ck *dnsCheck) Valid() error {
+	if err := validCheckBasics(ck.Name, ck.Hostname, ck.Resolution); err != nil {
+		return err
+	}
+
+	if ck.NameServer == "" {
+		return fmt.Errorf("invalid value for `NameServer`, must contain non-empty string")
+	}
+
+	if ck.ExpectedIP == "" {
+		return fmt.Errorf("invalid value for `ExpectedIP`, must contain non-empty string")
+	}
+
+	return nil
+}
+
+// udpCheck represents a Pingdom UDP check.
+type udpCheck struct {
+	Name                     string
+	Hostname                 string
+	Resolution               int
+	Paused                   bool
+	SendNotificationWhenDown int
+	NotifyAgainEvery         int
+	NotifyWhenBackup         bool
+	IntegrationIds           []int
+	Tags                     string
+	ProbeFilters             string
+	UserIds                  []int
+	TeamIds                  []int
+	Port                     int
+	StringToSend             string
+	StringToExpect           string
+}
+
+// PutParams returns a map of parameters for a udpCheck that can be sent along
+// with an HTTP PUT request.
+func (ck *udpCheck) PutParams() map[string]string {
+	m := map[string]string{
+		"name":             ck.Name,
+		"host":             ck.Hostname,
+		"resolution":       strconv.Itoa(ck.Resolution),
+		"paused":           strconv.FormatBool(ck.Paused),
+		"notifyagainevery": strconv.Itoa(ck.NotifyAgainEvery),
+		"notifywhenbackup": strconv.FormatBool(ck.NotifyWhenBackup),
+		"integrationids":   intListToCDString(ck.IntegrationIds),
+		"probe_filters":    ck.ProbeFilters,
+		"tags":             ck.Tags,
+		"userids":          intListToCDString(ck.UserIds),
+		"teamids":          intListToCDString(ck.TeamIds),
+		"port":             strconv.Itoa(ck.Port),
+		"stringtosend":     ck.StringToSend,
+		"stringtoexpect":   ck.StringToExpect,
+	}
+
+	if ck.SendNotificationWhenDown != 0 {
+		m["sendnotificationwhendown"] = strconv.Itoa(ck.SendNotificationWhenDown)
+	}
+
+	return m
+}
+
+// PostParams returns a map of parameters for a udpCheck that can be sent along
+// with an HTTP POST request. Same as PUT.
+func (ck *udpCheck) PostParams() map[string]string {
+	params := ck.PutParams()
+
+	for k, v := range params {
+		if v == "" {
+			delete(params, k)
+		}
+	}
+
+	params["type"] = "udp"
+	return params
+}
+
+// Valid determines whether the udpCheck contains valid fields. Unlike TCP
+// checks, the Pingdom API requires both strings to be set for UDP checks.
+func (ck *udpCheck) Valid() error {
+	if err := validCheckBasics(ck.Name, ck.Hostname, ck.Resolution); err != nil {
+		return err
+	}
+
+	if ck.Port < 1 {
+		return fmt.Errorf("invalid value for `Port`, must contain an integer >= 1")
+	}
+
+	if ck.StringToSend == "" {
+		return fmt.Errorf("invalid value for `StringToSend`, must contain non-empty string")
+	}
+
+	if ck.StringToExpect == "" {
+		return fmt.Errorf("invalid value for `StringToExpect`, must contain non-empty string")
+	}
+
+	return nil
+}
+
+// smtpCheck represents a Pingdom SMTP check.
+type smtpCheck struct {
+	Name                     string
+	Hostname                 string
+	Resolution               int
+	Paused                   bool
+	SendNotificationWhenDown int
+	NotifyAgainEvery         int
+	NotifyWhenBackup         bool
+	IntegrationIds           []int
+	Tags                     string
+	ProbeFilters             string
+	UserIds                  []int
+	TeamIds                  []int
+	Port                     int
+	Encryption               bool
+	StringToExpect           string
+	Username                 string
+	Password                 string
+}
+
+// PutParams returns a map of parameters for a smtpCheck that can be sent along
+// with an HTTP PUT request.
+func (ck *smtpCheck) PutParams() map[string]string {
+	m := map[string]string{
+		"name":             ck.Name,
+		"host":             ck.Hostname,
+		"resolution":       strconv.Itoa(ck.Resolution),
+		"paused":           strconv.FormatBool(ck.Paused),
+		"notifyagainevery": strconv.Itoa(ck.NotifyAgainEvery),
+		"notifywhenbackup": strconv.FormatBool(ck.NotifyWhenBackup),
+		"integrationids":   intListToCDString(ck.IntegrationIds),
+		"probe_filters":    ck.ProbeFilters,
+		"tags":             ck.Tags,
+		"userids":          intListToCDString(ck.UserIds),
+		"teamids":          intListToCDString(ck.TeamIds),
+		"encryption":       strconv.FormatBool(ck.Encryption),
+		"stringtoexpect":   ck.StringToExpect,
+	}
+
+	// Ignore zero values
+	if ck.Port != 0 {
+		m["port"] = strconv.Itoa(ck.Port)
+	}
+
+	if ck.SendNotificationWhenDown != 0 {
+		m["sendnotificationwhendown"] = strconv.Itoa(ck.SendNotificationWhenDown)
+	}
+
+	// Convert auth
+	if ck.Username != "" {
+		m["auth"] = fmt.Sprintf("%s:%s", ck.Username, ck.Password)
+	}
+
+	return m
+}
+
+// PostParams returns a map of parameters for a smtpCheck that can be sent along
+// with an HTTP POST request. Same as PUT.
+func (ck *smtpCheck) PostParams() map[string]string {
+	params := ck.PutParams()
+
+	for k, v := range params {
+		if v == "" {
+			delete(params, k)
+		}
+	}
+
+	params["type"] = "smtp"
+	return params
+}
+
+// Valid determines whether the smtpCheck contains valid fields.
+func (ck *smtpCheck) Valid() error {
+	return validCheckBasics(ck.Name, ck.Hostname, ck.Resolution)
+}
+
+// pop3Check represents a Pingdom POP3 check.
+type pop3Check struct {
+	Name                     string
+	Hostname                 string
+	Resolution               int
+	Paused                   bool
+	SendNotificationWhenDown int
+	NotifyAgainEvery         int
+	NotifyWhenBackup         bool
+	IntegrationIds           []int
+	Tags                     string
+	ProbeFilters             string
+	UserIds                  []int
+	TeamIds                  []int
+	Port                     int
+	Encryption               bool
+	StringToExpect           string
+}
+
+// PutParams returns a map of parameters for a pop3Check that can be sent along
+// with an HTTP PUT request.
+func (ck *pop3Check) PutParams() map[string]string {
+	m := map[string]string{
+		"name":             ck.Name,
+		"host":             ck.Hostname,
+		"resolution":       strconv.Itoa(ck.Resolution),
+		"paused":           strconv.FormatBool(ck.Paused),
+		"notifyagainevery": strconv.Itoa(ck.NotifyAgainEvery),
+		"notifywhenbackup": strconv.FormatBool(ck.NotifyWhenBackup),
+		"integrationids":   intListToCDString(ck.IntegrationIds),
+		"probe_filters":    ck.ProbeFilters,
+		"tags":             ck.Tags,
+		"userids":          intListToCDString(ck.UserIds),
+		"teamids":          intListToCDString(ck.TeamIds),
+		"encryption":       strconv.FormatBool(ck.Encryption),
+		"stringtoexpect":   ck.StringToExpect,
+	}
+
+	// Ignore zero values
+	if ck.Port != 0 {
+		m["port"] = strconv.Itoa(ck.Port)
+	}
+
+	if ck.SendNotificationWhenDown != 0 {
+		m["sendnotificationwhendown"] = strconv.Itoa(ck.SendNotificationWhenDown)
+	}
+
+	return m
+}
+
+// PostParams returns a map of parameters for a pop3Check that can be sent along
+// with an HTTP POST request. Same as PUT.
+func (ck *pop3Check) PostParams() map[string]string {
+	params := ck.PutParams()
+
+	for k, v := range params {
+		if v == "" {
+			delete(params, k)
+		}
+	}
+
+	params["type"] = "pop3"
+	return params
+}
+
+// Valid determines whether the pop3Check contains valid fields.
+func (ck *pop3Check) Valid() error {
+	return validCheckBasics(ck.Name, ck.Hostname, ck.Resolution)
+}
+
+// imapCheck represents a Pingdom IMAP check.
+type imapCheck struct {
+	Name                     string
+	Hostname                 string
+	Resolution               int
+	Paused                   bool
+	SendNotificationWhenDown int
+	NotifyAgainEvery         int
+	NotifyWhenBackup         bool
+	IntegrationIds           []int
+	Tags                     string
+	ProbeFilters             string
+	UserIds                  []int
+	TeamIds                  []int
+	Port                     int
+	Encryption               bool
+	StringToExpect           string
+}
+
+// PutParams returns a map of parameters for a imapCheck that can be sent along
+// with an HTTP PUT request.
+func (ck *imapCheck) PutParams() map[string]string {
+	m := map[string]string{
+		"name":             ck.Name,
+		"host":             ck.Hostname,
+		"resolution":       strconv.Itoa(ck.Resolution),
+		"paused":           strconv.FormatBool(ck.Paused),
+		"notifyagainevery": strconv.Itoa(ck.NotifyAgainEvery),
+		"notifywhenbackup": strconv.FormatBool(ck.NotifyWhenBackup),
+		"integrationids":   intListToCDString(ck.IntegrationIds),
+		"probe_filters":    ck.ProbeFilters,
+		"tags":             ck.Tags,
+		"userids":          intListToCDString(ck.UserIds),
+		"teamids":          intListToCDString(ck.TeamIds),
+		"encryption":       strconv.FormatBool(ck.Encryption),
+		"stringtoexpect":   ck.StringToExpect,
+	}
+
+	// Ignore zero values
+	if ck.Port != 0 {
+		m["port"] = strconv.Itoa(ck.Port)
+	}
+
+	if ck.SendNotificationWhenDown != 0 {
+		m["sendnotificationwhendown"] = strconv.Itoa(ck.SendNotificationWhenDown)
+	}
+
+	return m
+}
+
+// PostParams returns a map of parameters for a imapCheck that can be sent along
+// with an HTTP POST request. Same as PUT.
+func (ck *imapCheck) PostParams() map[string]string {
+	params := ck.PutParams()
+
+	for k, v := range params {
+		if v == "" {
+			delete(params, k)
+		}
+	}
+
+	params["type"] = "imap"
+	return params
+}
+
+// Valid determines whether the imapCheck contains valid fields.
+func (ck *imapCheck) Valid() error {
+	return validCheckBasics(ck.Name, ck.Hostname, ck.Resolution)
+}
+
+// httpCustomCheck represents a Pingdom custom HTTP check, which polls an XML
+// status document and may verify additional URLs.
+type httpCustomCheck struct {
+	Name                     string
+	Hostname                 string
+	Resolution               int
+	Paused                   bool
+	SendNotificationWhenDown int
+	NotifyAgainEvery         int
+	NotifyWhenBackup         bool
+	IntegrationIds           []int
+	Tags                     string
+	ProbeFilters             string
+	UserIds                  []int
+	TeamIds                  []int
+	Url                      string
+	Encryption               bool
+	Port                     int
+	Username                 string
+	Password                 string
+	AdditionalUrls           []string
+}
+
+// PutParams returns a map of parameters for an httpCustomCheck that can be
+// sent along with an HTTP PUT request.
+func (ck *httpCustomCheck) PutParams() map[string]string {
+	m := map[string]string{
+		"name":             ck.Name,
+		"host":             ck.Hostname,
+		"resolution":       strconv.Itoa(ck.Resolution),
+		"paused":           strconv.FormatBool(ck.Paused),
+		"notifyagainevery": strconv.Itoa(ck.NotifyAgainEvery),
+		"notifywhenbackup": strconv.FormatBool(ck.NotifyWhenBackup),
+		"integrationids":   intListToCDString(ck.IntegrationIds),
+		"probe_filters":    ck.ProbeFilters,
+		"tags":             ck.Tags,
+		"userids":          intListToCDString(ck.UserIds),
+		"teamids":          intListToCDString(ck.TeamIds),
+		"url":              ck.Url,
+		"encryption":       strconv.FormatBool(ck.Encryption),
+		"additionalurls":   strings.Join(ck.AdditionalUrls, ";"),
+	}
+
+	// Ignore zero values
+	if ck.Port != 0 {
+		m["port"] = strconv.Itoa(ck.Port)
+	}
+
+	if ck.SendNotificationWhenDown != 0 {
+		m["sendnotificationwhendown"] = strconv.Itoa(ck.SendNotificationWhenDown)
+	}
+
+	// Convert auth
+	if ck.Username != "" {
+		m["auth"] = fmt.Sprintf("%s:%s", ck.Username, ck.Password)
+	}
+
+	return m
+}
+
+// PostParams returns a map of parameters for an httpCustomCheck that can be
+// sent along with an HTTP POST request. Same as PUT.
+func (ck *httpCustomCheck) PostParams() map[string]string {
+	params := ck.PutParams()
+
+	for k, v := range params {
+		if v == "" {
+			delete(params, k)
+		}
+	}
+
+	params["type"] = "httpcustom"
+	return params
+}
+
+// Valid determines whether the httpCustomCheck contains valid fields.
+func (ck *httpCustomCheck) Valid() error {
+	if err := validCheckBasics(ck.Name, ck.Hostname, ck.Resolution); err != nil {
+		return err
+	}
+
+	if ck.Url == "" {
+		return fmt.Errorf("invalid value for `Url`, must contain non-empty string")
+	}
+
+	return nil
+}
+
+// extendedCheck adds the parameters every check type accepts, but which
+// go-pingdom does not know about, to a check.
+type extendedCheck struct {
+	pingdom.Check
+	CustomMessage string
+	IPv6          bool
+}
+
+// PutParams returns the parameters of the wrapped check along with the
+// extended ones.
+func (ck *extendedCheck) PutParams() map[string]string {
+	m := ck.Check.PutParams()
+	m["custom_message"] = ck.CustomMessage
+	m["ipv6"] = strconv.FormatBool(ck.IPv6)
+	return m
+}
+
+// PostParams returns the parameters of the wrapped check along with the
+// extended ones, leaving out empty values.
+func (ck *extendedCheck) PostParams() map[string]string {
+	m := ck.Check.PostParams()
+	if ck.CustomMessage != "" {
+		m["custom_message"] = ck.CustomMessage
+	}
+	m["ipv6"] = strconv.FormatBool(ck.IPv6)
+	return m
+}
+
+// validCheckBasics validates the fields every check type requires.
+func validCheckBasics(name string, hostname string, resolution int) error {
+	if name == "" {
+		return fmt.Errorf("invalid value for `Name`, must contain non-empty string")
+	}
+
+	if hostname == "" {
+		return fmt.Errorf("invalid value for `Hostname`, must contain non-empty string")
+	}
+
+	if resolution != 1 && resolution != 5 && resolution != 15 &&
+		resolution != 30 && resolution != 60 {
+		return fmt.Errorf("invalid value %v for `Resolution`, allowed values are [1,5,15,30,60]", resolution)
+	}
+
+	return nil
+}
+
+func intListToCDString(integers []int) string {
+	var CDString string
+	for i, item := range integers {
+		if i == 0 {
+			CDString = strconv.Itoa(item)
+		} else {
+			CDString = fmt.Sprintf("%v,%d", CDString, item)
+		}
+	}
+	return CDString
+}
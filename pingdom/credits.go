@@ -0,0 +1,107 @@
+package pingdom
+
+import (
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+// credits holds the check and SMS credits of the account, as returned by
+// /credits.
+type credits struct {
+	CheckLimit        int  `json:"checklimit"`
+	AvailableChecks   int  `json:"availablechecks"`
+	UsedDefault       int  `json:"useddefault"`
+	UsedTransaction   int  `json:"usedtransaction"`
+	AvailableSMS      int  `json:"availablesms"`
+	AvailableSMSTests int  `json:"availablesmstests"`
+	AutoFillSMS       bool `json:"autofillsms"`
+	AutoFillSMSAmount int  `json:"autofillsms_amount"`
+	AutoFillSMSWhen   int  `json:"autofillsms_when_left"`
+	MaxSMSOverage     int  `json:"max_sms_overage"`
+	AvailableRUMSites int  `json:"availablerumsites"`
+	MaxRUMFilters     int  `json:"maxrumfilters"`
+	MaxRUMPageViews   int  `json:"maxrumpageviews"`
+}
+
+type creditsJSONResponse struct {
+	Credits credits `json:"credits"`
+}
+
+// readCredits returns the credits of the account.
+func readCredits(client *pingdom.Client) (*credits, error) {
+	m := &creditsJSONResponse{}
+	if err := doRequest(client, "GET", "/credits", nil, m); err != nil {
+		return nil, err
+	}
+	return &m.Credits, nil
+}
+
+// checkQuota tracks the checks planned for creation against the check credits
+// available to a client, so a plan creating more checks than the account
+// allows fails before anything is created.
+type checkQuota struct {
+	mu        sync.Mutex
+	loaded    bool
+	available int
+	released  int
+	planned   int
+}
+
+// checkQuotas holds the quota of each configured client. Terraform configures
+// the provider afresh for every plan and apply, each with a new client, so a
+// quota only ever counts the checks of a single plan.
+var (
+	checkQuotasMu sync.Mutex
+	checkQuotas   = map[*pingdom.Client]*checkQuota{}
+)
+
+func checkQuotaForClient(client *pingdom.Client) *checkQuota {
+	checkQuotasMu.Lock()
+	defer checkQuotasMu.Unlock()
+
+	quota, ok := checkQuotas[client]
+	if !ok {
+		quota = &checkQuota{}
+		checkQuotas[client] = quota
+	}
+	return quota
+}
+
+// reserveCheckCredit records that a check is planned for creation and returns
+// an error if the planned checks exceed the available check credits. If the
+// credits cannot be read the check is allowed, leaving it to the API to refuse
+// it.
+func reserveCheckCredit(client *pingdom.Client) error {
+	quota := checkQuotaForClient(client)
+	quota.mu.Lock()
+	defer quota.mu.Unlock()
+
+	if !quota.loaded {
+		c, err := readCredits(client)
+		if err != nil {
+			log.Printf("[WARN] Unable to read check credits, not checking the check quota: %s", err)
+			return nil
+		}
+		quota.available = c.AvailableChecks
+		quota.loaded = true
+	}
+
+	quota.planned++
+	if available := quota.available + quota.released; quota.planned > available {
+		return fmt.Errorf("creating %d new checks would exceed the %d check credits available on the account", quota.planned, available)
+	}
+	return nil
+}
+
+// releaseCheckCredit records that a check planned for replacement frees its
+// credit for the check replacing it.
+func releaseCheckCredit(client *pingdom.Client) {
+	quota := checkQuotaForClient(client)
+	quota.mu.Lock()
+	defer quota.mu.Unlock()
+
+	quota.released++
+}
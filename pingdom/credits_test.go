@@ -0,0 +1,64 @@
+package pingdom
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+func testCreditsClient(t *testing.T, availableChecks string) (*pingdom.Client, func()) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"credits":{"checklimit":10,"availablechecks":` + availableChecks + `,"useddefault":8}}`))
+	}))
+
+	client, err := pingdom.NewClientWithConfig(pingdom.ClientConfig{APIToken: "token", BaseURL: server.URL})
+	if err != nil {
+		server.Close()
+		t.Fatalf("Error creating client: %s", err)
+	}
+	return client, server.Close
+}
+
+func TestReserveCheckCredit(t *testing.T) {
+	client, closeServer := testCreditsClient(t, "2")
+	defer closeServer()
+
+	for i, valid := range []bool{true, true, false} {
+		err := reserveCheckCredit(client)
+		if (err == nil) != valid {
+			t.Errorf("reserveCheckCredit #%d: expected valid=%v, got error %v", i, valid, err)
+		}
+	}
+
+	// A replaced check frees its credit.
+	releaseCheckCredit(client)
+	if err := reserveCheckCredit(client); err == nil {
+		t.Errorf("reserveCheckCredit after release: expected an error as three checks are still planned")
+	}
+	releaseCheckCredit(client)
+	if err := reserveCheckCredit(client); err == nil {
+		t.Errorf("reserveCheckCredit after second release: expected an error for the fifth check")
+	}
+}
+
+func TestCheckQuotaCountsChecksSharingNameAndHost(t *testing.T) {
+	client, closeServer := testCreditsClient(t, "1")
+	defer closeServer()
+
+	// Two checks of the same host differing only in their url each need a
+	// credit.
+	configs := []map[string]interface{}{
+		{"name": "api", "host": "example.com", "url": "/health", "resolution": 5},
+		{"name": "api", "host": "example.com", "url": "/status", "resolution": 5},
+	}
+
+	for i, config := range configs {
+		_, err := resourcePingdomHTTPCheck().Diff(nil, terraform.NewResourceConfigRaw(config), client)
+		if valid := i == 0; (err == nil) != valid {
+			t.Errorf("Diff(%v): expected valid=%v, got error %v", config, valid, err)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package pingdom
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+func dataSourcePingdomAlerts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePingdomAlertsRead,
+
+		Schema: map[string]*schema.Schema{
+			"from": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"check_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"contact_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"status": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"sent", "delivered", "error", "not_delivered", "no_credits"}, false),
+				},
+			},
+			"alerts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"check_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"contact_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"contact_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"via": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sent_to": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message_short": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message_full": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"charged": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePingdomAlertsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	from, to, err := timeRangeForResource(d)
+	if err != nil {
+		return err
+	}
+
+	params := map[string]string{
+		"from": strconv.FormatInt(from, 10),
+		"to":   strconv.FormatInt(to, 10),
+	}
+
+	if checkIDs := intSetToSlice(d.Get("check_ids")); len(checkIDs) > 0 {
+		sort.Ints(checkIDs)
+		params["checkids"] = intListToCDString(checkIDs)
+	}
+
+	if contactIDs := intSetToSlice(d.Get("contact_ids")); len(contactIDs) > 0 {
+		sort.Ints(contactIDs)
+		params["userids"] = intListToCDString(contactIDs)
+	}
+
+	var statuses []string
+	for _, status := range d.Get("status").(*schema.Set).List() {
+		statuses = append(statuses, status.(string))
+	}
+	if len(statuses) > 0 {
+		sort.Strings(statuses)
+		params["status"] = strings.Join(statuses, ",")
+	}
+
+	alerts, err := listAlerts(client, params)
+	if err != nil {
+		return fmt.Errorf("Error retrieving alerts: %s", err)
+	}
+
+	result := []map[string]interface{}{}
+	for _, a := range alerts {
+		result = append(result, map[string]interface{}{
+			"check_id":      a.CheckID,
+			"contact_id":    a.ContactID,
+			"contact_name":  a.ContactName,
+			"via":           a.Via,
+			"sent_to":       a.SentTo,
+			"status":        a.Status,
+			"message_short": a.MessageShort,
+			"message_full":  a.MessageFull,
+			"time":          unixToRFC3339(a.Time),
+			"charged":       a.Charged,
+		})
+	}
+	if err := d.Set("alerts", result); err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(fmt.Sprintf("%s-%s-%s-%s-%s", params["from"], params["to"], params["checkids"], params["userids"], params["status"]))))
+	return nil
+}
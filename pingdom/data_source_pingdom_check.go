@@ -0,0 +1,116 @@
+package pingdom
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+func dataSourcePingdomCheck() *schema.Resource {
+	s := computedSchema(resourcePingdomCheck().Schema)
+	s["id"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"name"},
+	}
+	s["name"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"id"},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourcePingdomCheckRead,
+		Schema: s,
+	}
+}
+
+// computedSchema returns a copy of a resource schema with every attribute
+// turned into a computed one, for data sources exposing the same attributes.
+func computedSchema(in map[string]*schema.Schema) map[string]*schema.Schema {
+	out := make(map[string]*schema.Schema, len(in))
+	for k, v := range in {
+		s := &schema.Schema{
+			Type:      v.Type,
+			Computed:  true,
+			Sensitive: v.Sensitive || k == "password",
+			Set:       v.Set,
+		}
+		if elem, ok := v.Elem.(*schema.Schema); ok {
+			s.Elem = &schema.Schema{Type: elem.Type}
+		}
+		out[k] = s
+	}
+	return out
+}
+
+// findCheckID returns the ID of the check with the given ID or exact name.
+func findCheckID(client *pingdom.Client, id string, name string) (int, error) {
+	if id != "" {
+		checkID, err := strconv.Atoi(id)
+		if err != nil {
+			return 0, fmt.Errorf("Error parsing check id '%s': %s", id, err)
+		}
+		return checkID, nil
+	}
+	if name == "" {
+		return 0, fmt.Errorf("one of id or name must be set")
+	}
+
+	checks, err := client.Checks.List()
+	if err != nil {
+		return 0, fmt.Errorf("Error retrieving list of checks: %s", err)
+	}
+
+	found := 0
+	for _, ck := range checks {
+		if ck.Name != name {
+			continue
+		}
+		if found != 0 {
+			return 0, fmt.Errorf("multiple checks named '%s' found", name)
+		}
+		found = ck.ID
+	}
+	if found == 0 {
+		return 0, fmt.Errorf("Check '%s' not found", name)
+	}
+	return found, nil
+}
+
+func dataSourcePingdomCheckRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	id, err := findCheckID(client, d.Get("id").(string), d.Get("name").(string))
+	if err != nil {
+		return err
+	}
+
+	ck, details, err := readCheck(client, id)
+	if err != nil {
+		return fmt.Errorf("Error retrieving check: %s", err)
+	}
+
+	if err := updateResourceFromCheck(d, ck, details); err != nil {
+		return err
+	}
+
+	if http := ck.Type.HTTP; http != nil {
+		t := &targetURL{
+			Hostname:   ck.Hostname,
+			Url:        http.Url,
+			Port:       http.Port,
+			Encryption: http.Encryption,
+		}
+		if err := d.Set("target_url", t.String()); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(strconv.Itoa(ck.ID))
+	return nil
+}
@@ -0,0 +1,109 @@
+package pingdom
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+func dataSourcePingdomCheckOutages() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePingdomCheckOutagesRead,
+
+		Schema: map[string]*schema.Schema{
+			"check_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"from": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"up", "down", "unknown"}, false),
+			},
+			"total_downtime": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"intervals": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"from": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"to": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"duration": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePingdomCheckOutagesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	id := d.Get("check_id").(int)
+	from, to, err := timeRangeForResource(d)
+	if err != nil {
+		return err
+	}
+	status := d.Get("status").(string)
+
+	states, err := readSummaryOutage(client, id, from, to)
+	if err != nil {
+		return fmt.Errorf("Error retrieving outage summary: %s", err)
+	}
+
+	totalDowntime := int64(0)
+	intervals := []map[string]interface{}{}
+	for _, state := range states {
+		duration := state.TimeTo - state.TimeFrom
+		if state.Status == "down" {
+			totalDowntime += duration
+		}
+		if status != "" && state.Status != status {
+			continue
+		}
+		intervals = append(intervals, map[string]interface{}{
+			"status":   state.Status,
+			"from":     unixToRFC3339(state.TimeFrom),
+			"to":       unixToRFC3339(state.TimeTo),
+			"duration": int(duration),
+		})
+	}
+
+	if err := d.Set("total_downtime", int(totalDowntime)); err != nil {
+		return err
+	}
+	if err := d.Set("intervals", intervals); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%d-%d-%d", id, from, to))
+	return nil
+}
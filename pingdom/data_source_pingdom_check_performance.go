@@ -0,0 +1,185 @@
+package pingdom
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+// performanceResolutions maps each performance resolution to its length in
+// seconds.
+var performanceResolutions = map[string]int64{
+	"hour": 60 * 60,
+	"day":  24 * 60 * 60,
+	"week": 7 * 24 * 60 * 60,
+}
+
+// performanceIntervalSchema returns the schema of a performance interval.
+// The API does not report uptime for a subset of probes, so the uptime
+// attributes are left out of per-probe intervals.
+func performanceIntervalSchema(includeUptime bool) *schema.Resource {
+	s := map[string]*schema.Schema{
+		"from": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"to": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"avg_response": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+	}
+	if includeUptime {
+		for _, k := range []string{"uptime", "downtime", "unmonitored"} {
+			s[k] = &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			}
+		}
+	}
+	return &schema.Resource{Schema: s}
+}
+
+func dataSourcePingdomCheckPerformance() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePingdomCheckPerformanceRead,
+
+		Schema: map[string]*schema.Schema{
+			"check_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"from": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"resolution": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "hour",
+				ValidateFunc: validation.StringInSlice([]string{"hour", "day", "week"}, false),
+			},
+			"probes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"split_by_probe": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"intervals": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     performanceIntervalSchema(true),
+			},
+			"probe_intervals": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"probe_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"intervals": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     performanceIntervalSchema(false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// flattenPerformanceIntervals converts performance intervals to attribute
+// values, ending each interval at the start of the next or at to.
+func flattenPerformanceIntervals(intervals []summaryPerformanceInterval, resolution string, to int64, includeUptime bool) []map[string]interface{} {
+	result := []map[string]interface{}{}
+	for _, interval := range intervals {
+		end := interval.StartTime + performanceResolutions[resolution]
+		if end > to {
+			end = to
+		}
+		m := map[string]interface{}{
+			"from":         unixToRFC3339(interval.StartTime),
+			"to":           unixToRFC3339(end),
+			"avg_response": interval.AvgResponse,
+		}
+		if includeUptime {
+			m["uptime"] = interval.Uptime
+			m["downtime"] = interval.Downtime
+			m["unmonitored"] = interval.Unmonitored
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
+func dataSourcePingdomCheckPerformanceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	id := d.Get("check_id").(int)
+	from, to, err := timeRangeForResource(d)
+	if err != nil {
+		return err
+	}
+	resolution := d.Get("resolution").(string)
+	probes := intSetToSlice(d.Get("probes"))
+	sort.Ints(probes)
+
+	intervals, err := readSummaryPerformance(client, id, from, to, resolution, nil)
+	if err != nil {
+		return fmt.Errorf("Error retrieving performance summary: %s", err)
+	}
+	if err := d.Set("intervals", flattenPerformanceIntervals(intervals, resolution, to, true)); err != nil {
+		return err
+	}
+
+	probeIntervals := []map[string]interface{}{}
+	if len(probes) > 0 || d.Get("split_by_probe").(bool) {
+		// Without a probe filter, break down by every probe that ran the
+		// check.
+		if len(probes) == 0 {
+			averages, err := readSummaryByProbe(client, id, from, to)
+			if err != nil {
+				return fmt.Errorf("Error retrieving performance summary by probe: %s", err)
+			}
+			for _, average := range averages {
+				probes = append(probes, average.ProbeID)
+			}
+			sort.Ints(probes)
+		}
+
+		for _, probeID := range probes {
+			intervals, err := readSummaryPerformance(client, id, from, to, resolution, []int{probeID})
+			if err != nil {
+				return fmt.Errorf("Error retrieving performance summary for probe %d: %s", probeID, err)
+			}
+			probeIntervals = append(probeIntervals, map[string]interface{}{
+				"probe_id":  probeID,
+				"intervals": flattenPerformanceIntervals(intervals, resolution, to, false),
+			})
+		}
+	}
+	if err := d.Set("probe_intervals", probeIntervals); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%d-%d-%d-%s", id, from, to, resolution))
+	return nil
+}
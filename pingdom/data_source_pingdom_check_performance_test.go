@@ -0,0 +1,54 @@
+package pingdom
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+func TestDataSourcePingdomCheckPerformanceReadProbes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("probes") != "" {
+			if q.Get("includeuptime") != "" {
+				t.Errorf("includeuptime sent together with probes %s", q.Get("probes"))
+			}
+			w.Write([]byte(`{"summary":{"hours":[{"starttime":1600000000,"avgresponse":300}]}}`))
+			return
+		}
+		w.Write([]byte(`{"summary":{"hours":[{"starttime":1600000000,"avgresponse":200,"uptime":3000,"downtime":600}]}}`))
+	}))
+	defer server.Close()
+
+	client, err := pingdom.NewClientWithConfig(pingdom.ClientConfig{APIToken: "token", BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourcePingdomCheckPerformance().Schema, map[string]interface{}{
+		"check_id": 1,
+		"from":     "2020-09-13T12:00:00Z",
+		"to":       "2020-09-13T13:00:00Z",
+		"probes":   []interface{}{42},
+	})
+	if err := dataSourcePingdomCheckPerformanceRead(d, client); err != nil {
+		t.Fatalf("Error reading performance: %s", err)
+	}
+
+	if v := d.Get("intervals.0.downtime").(int); v != 600 {
+		t.Errorf("Expected downtime 600 over all probes, got %d", v)
+	}
+	if v := d.Get("probe_intervals.0.probe_id").(int); v != 42 {
+		t.Errorf("Expected probe 42, got %d", v)
+	}
+	interval := d.Get("probe_intervals.0.intervals.0").(map[string]interface{})
+	if interval["avg_response"] != 300 {
+		t.Errorf("Expected probe avg_response 300, got %v", interval["avg_response"])
+	}
+	if _, ok := interval["downtime"]; ok {
+		t.Errorf("Expected no downtime in probe intervals, got %v", interval)
+	}
+}
@@ -0,0 +1,196 @@
+package pingdom
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+const (
+	// resultsPageSize is the largest number of results the API returns at once.
+	resultsPageSize = 1000
+	// resultsMaxOffset is the largest offset the API accepts.
+	resultsMaxOffset = 43200
+)
+
+func dataSourcePingdomCheckResults() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePingdomCheckResultsRead,
+
+		Schema: map[string]*schema.Schema{
+			"check_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"from": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"probes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"status": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"up", "down", "unconfirmed", "unknown"}, false),
+				},
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"offset": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, resultsMaxOffset),
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"probe_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"response_time": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status_desc": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_desc_long": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// listCheckResults returns the results of a check, newest first, skipping the
+// first offset results and returning at most limit results, or all of them
+// when limit is 0. Pages are requested until the time range is exhausted; once
+// the offset would exceed what the API accepts, the end of the time range is
+// moved back to the oldest result seen instead.
+func listCheckResults(client *pingdom.Client, id int, from int64, to int64, probes []int, statuses []string, offset int, limit int) ([]pingdom.Result, error) {
+	results := []pingdom.Result{}
+	// seen holds the probes whose results at time to were already listed,
+	// after the end of the time range was moved back.
+	seen := map[int]bool{}
+
+	for limit == 0 || len(results) < limit {
+		params := summaryParams(from, to, probes)
+		if len(statuses) > 0 {
+			params["status"] = strings.Join(statuses, ",")
+		}
+		params["limit"] = fmt.Sprintf("%d", resultsPageSize)
+		params["offset"] = fmt.Sprintf("%d", offset)
+
+		page, err := client.Checks.Results(id, params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, result := range page.Results {
+			if int64(result.Time) == to && seen[result.ProbeID] {
+				continue
+			}
+			results = append(results, result)
+		}
+		if len(page.Results) < resultsPageSize || len(results) == 0 {
+			break
+		}
+
+		offset += len(page.Results)
+		if offset+resultsPageSize > resultsMaxOffset {
+			oldest := int64(results[len(results)-1].Time)
+			if oldest == to {
+				break
+			}
+			to = oldest
+			offset = 0
+			seen = map[int]bool{}
+			for i := len(results) - 1; i >= 0 && int64(results[i].Time) == oldest; i-- {
+				seen[results[i].ProbeID] = true
+			}
+		}
+	}
+
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+func dataSourcePingdomCheckResultsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	id := d.Get("check_id").(int)
+	from, to, err := timeRangeForResource(d)
+	if err != nil {
+		return err
+	}
+
+	probes := intSetToSlice(d.Get("probes"))
+	sort.Ints(probes)
+
+	var statuses []string
+	for _, status := range d.Get("status").(*schema.Set).List() {
+		statuses = append(statuses, status.(string))
+	}
+	sort.Strings(statuses)
+
+	offset := d.Get("offset").(int)
+	limit := d.Get("limit").(int)
+
+	results, err := listCheckResults(client, id, from, to, probes, statuses, offset, limit)
+	if err != nil {
+		return fmt.Errorf("Error retrieving check results: %s", err)
+	}
+
+	flattened := []map[string]interface{}{}
+	for _, result := range results {
+		flattened = append(flattened, map[string]interface{}{
+			"probe_id":         result.ProbeID,
+			"time":             unixToRFC3339(int64(result.Time)),
+			"status":           result.Status,
+			"response_time":    result.ResponseTime,
+			"status_desc":      result.StatusDesc,
+			"status_desc_long": result.StatusDescLong,
+		})
+	}
+	if err := d.Set("results", flattened); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%d-%d-%d-%d-%d", id, from, to, offset, limit))
+	return nil
+}
@@ -0,0 +1,70 @@
+package pingdom
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+func TestListCheckResults(t *testing.T) {
+	// Serve one result per second, newest first, honouring from, to, limit
+	// and offset like the results API.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		from, _ := strconv.Atoi(q.Get("from"))
+		to, _ := strconv.Atoi(q.Get("to"))
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		if offset > resultsMaxOffset || limit > resultsPageSize {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":{"statuscode":400,"statusdesc":"Bad Request","errormessage":"invalid offset or limit"}}`))
+			return
+		}
+
+		results := []pingdom.Result{}
+		for i := to - offset; i >= from && len(results) < limit; i-- {
+			results = append(results, pingdom.Result{ProbeID: 1, Time: i, Status: "up"})
+		}
+		json.NewEncoder(w).Encode(pingdom.ResultsResponse{Results: results})
+	}))
+	defer server.Close()
+
+	client, err := pingdom.NewClientWithConfig(pingdom.ClientConfig{APIToken: "token", BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err)
+	}
+
+	cases := []struct {
+		from     int64
+		to       int64
+		offset   int
+		limit    int
+		expected int
+	}{
+		{0, 99, 0, 0, 100},
+		{0, 99, 10, 0, 90},
+		{0, 9999, 0, 1500, 1500},
+		{0, 49999, 0, 0, 50000},
+		{0, 49999, 100, 45000, 45000},
+	}
+
+	for _, tc := range cases {
+		results, err := listCheckResults(client, 1, tc.from, tc.to, nil, nil, tc.offset, tc.limit)
+		if err != nil {
+			t.Fatalf("listCheckResults(%d, %d, %d, %d): %s", tc.from, tc.to, tc.offset, tc.limit, err)
+		}
+		if len(results) != tc.expected {
+			t.Errorf("listCheckResults(%d, %d, %d, %d): expected %d results, got %d", tc.from, tc.to, tc.offset, tc.limit, tc.expected, len(results))
+		}
+		for i, result := range results {
+			if expected := int(tc.to) - tc.offset - i; result.Time != expected {
+				t.Errorf("listCheckResults(%d, %d, %d, %d): expected result %d at %d, got %d", tc.from, tc.to, tc.offset, tc.limit, i, expected, result.Time)
+				break
+			}
+		}
+	}
+}
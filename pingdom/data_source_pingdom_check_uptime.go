@@ -0,0 +1,214 @@
+package pingdom
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+func dataSourcePingdomCheckUptime() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePingdomCheckUptimeRead,
+
+		Schema: map[string]*schema.Schema{
+			"check_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"from": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"split_by": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"probe", "region"}, false),
+			},
+			"uptime": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"downtime": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"unmonitored": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"availability": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"avg_response": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"splits": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"probe_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"avg_response": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// timeRangeForResource returns the from and to attributes as Unix timestamps.
+// to defaults to the current time.
+func timeRangeForResource(d *schema.ResourceData) (int64, int64, error) {
+	from, err := time.Parse(time.RFC3339, d.Get("from").(string))
+	if err != nil {
+		return 0, 0, fmt.Errorf("Error parsing from: %s", err)
+	}
+
+	to := time.Now()
+	if v, ok := d.GetOk("to"); ok {
+		to, err = time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return 0, 0, fmt.Errorf("Error parsing to: %s", err)
+		}
+	}
+
+	if !to.After(from) {
+		return 0, 0, fmt.Errorf("to must be after from")
+	}
+	return from.Unix(), to.Unix(), nil
+}
+
+// uptimeSplits returns the average response time of a check for each probe
+// or region that ran it. The API does not report uptime for a subset of
+// probes, so splits only carry response times. Region averages are weighted
+// by the number of results of each probe.
+func uptimeSplits(client *pingdom.Client, id int, from int64, to int64, splitBy string) ([]map[string]interface{}, error) {
+	probes, err := client.Probes.List()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving list of probes: %s", err)
+	}
+	regions := map[int]string{}
+	for _, probe := range probes {
+		regions[probe.ID] = probe.Region
+	}
+
+	averages, err := readSummaryByProbe(client, id, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving uptime summary by probe: %s", err)
+	}
+	sort.Slice(averages, func(i, j int) bool { return averages[i].ProbeID < averages[j].ProbeID })
+
+	splits := []map[string]interface{}{}
+
+	if splitBy == "probe" {
+		for _, average := range averages {
+			splits = append(splits, map[string]interface{}{
+				"probe_id":     average.ProbeID,
+				"region":       regions[average.ProbeID],
+				"avg_response": average.AvgResponse,
+			})
+		}
+		return splits, nil
+	}
+
+	byRegion := map[string][]summaryProbeAverage{}
+	for _, average := range averages {
+		region := regions[average.ProbeID]
+		byRegion[region] = append(byRegion[region], average)
+	}
+	names := []string{}
+	for region := range byRegion {
+		names = append(names, region)
+	}
+	sort.Strings(names)
+
+	for _, region := range names {
+		splits = append(splits, map[string]interface{}{
+			"probe_id":     0,
+			"region":       region,
+			"avg_response": weightedAvgResponse(byRegion[region]),
+		})
+	}
+	return splits, nil
+}
+
+// weightedAvgResponse returns the average response time over the results of
+// several probes.
+func weightedAvgResponse(averages []summaryProbeAverage) int {
+	total, n := 0, 0
+	for _, average := range averages {
+		total += average.AvgResponse * average.N
+		n += average.N
+	}
+	if n == 0 {
+		return 0
+	}
+	return total / n
+}
+
+func dataSourcePingdomCheckUptimeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	id := d.Get("check_id").(int)
+	from, to, err := timeRangeForResource(d)
+	if err != nil {
+		return err
+	}
+
+	summary, err := readSummaryAverage(client, id, from, to)
+	if err != nil {
+		return fmt.Errorf("Error retrieving uptime summary: %s", err)
+	}
+
+	if err := d.Set("uptime", summary.Status.TotalUp); err != nil {
+		return err
+	}
+	if err := d.Set("downtime", summary.Status.TotalDown); err != nil {
+		return err
+	}
+	if err := d.Set("unmonitored", summary.Status.TotalUnknown); err != nil {
+		return err
+	}
+	if err := d.Set("availability", summary.Status.availability()); err != nil {
+		return err
+	}
+	if err := d.Set("avg_response", summary.ResponseTime.AvgResponse); err != nil {
+		return err
+	}
+
+	splits := []map[string]interface{}{}
+	if splitBy, ok := d.GetOk("split_by"); ok {
+		splits, err = uptimeSplits(client, id, from, to, splitBy.(string))
+		if err != nil {
+			return err
+		}
+	}
+	if err := d.Set("splits", splits); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%d-%d-%d", id, from, to))
+	return nil
+}
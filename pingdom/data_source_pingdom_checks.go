@@ -0,0 +1,168 @@
+package pingdom
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+func dataSourcePingdomChecks() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePingdomChecksRead,
+
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"http", "httpcustom", "ping", "tcp", "udp", "dns", "smtp", "pop3", "imap"}, false),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"up", "down", "unconfirmed_down", "unknown", "paused"}, false),
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"checks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"host": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// checkHasTags reports whether a check carries every one of the given tags.
+func checkHasTags(ck pingdom.CheckResponse, tags []string) bool {
+	names := map[string]bool{}
+	for _, tag := range ck.Tags {
+		names[tag.Name] = true
+	}
+	for _, tag := range tags {
+		if !names[tag] {
+			return false
+		}
+	}
+	return true
+}
+
+func dataSourcePingdomChecksRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	var tags []string
+	for _, tag := range d.Get("tags").(*schema.Set).List() {
+		tags = append(tags, tag.(string))
+	}
+	sort.Strings(tags)
+
+	checkType := d.Get("type").(string)
+	status := d.Get("status").(string)
+
+	var nameRegexp *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegexp = regexp.MustCompile(v.(string))
+	}
+
+	params := map[string]string{"include_tags": "true"}
+	if len(tags) > 0 {
+		// The API returns checks carrying any of the tags; checks missing
+		// some of them are filtered out below.
+		params["tags"] = strings.Join(tags, ",")
+	}
+
+	checks, err := client.Checks.List(params)
+	if err != nil {
+		return fmt.Errorf("Error retrieving list of checks: %s", err)
+	}
+	sort.Slice(checks, func(i, j int) bool { return checks[i].ID < checks[j].ID })
+
+	ids := []int{}
+	result := []map[string]interface{}{}
+	for _, ck := range checks {
+		if checkType != "" && ck.Type.Name != checkType {
+			continue
+		}
+		if status != "" && ck.Status != status {
+			continue
+		}
+		if nameRegexp != nil && !nameRegexp.MatchString(ck.Name) {
+			continue
+		}
+		if !checkHasTags(ck, tags) {
+			continue
+		}
+
+		ckTags := []string{}
+		for _, tag := range ck.Tags {
+			ckTags = append(ckTags, tag.Name)
+		}
+
+		ids = append(ids, ck.ID)
+		result = append(result, map[string]interface{}{
+			"id":     ck.ID,
+			"name":   ck.Name,
+			"host":   ck.Hostname,
+			"type":   ck.Type.Name,
+			"status": ck.Status,
+			"tags":   ckTags,
+		})
+	}
+
+	if err := d.Set("ids", ids); err != nil {
+		return err
+	}
+	if err := d.Set("checks", result); err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(fmt.Sprintf("%s-%s-%s-%s", strings.Join(tags, ","), checkType, status, d.Get("name_regex")))))
+	return nil
+}
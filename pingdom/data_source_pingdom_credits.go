@@ -0,0 +1,102 @@
+package pingdom
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+func dataSourcePingdomCredits() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePingdomCreditsRead,
+
+		Schema: map[string]*schema.Schema{
+			"check_limit": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"available_checks": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"used_default_checks": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"used_transaction_checks": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"available_sms": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"available_sms_tests": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"auto_fill_sms": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"auto_fill_sms_amount": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"auto_fill_sms_when_left": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"max_sms_overage": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"available_rum_sites": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"max_rum_filters": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"max_rum_page_views": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePingdomCreditsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	c, err := readCredits(client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving credits: %s", err)
+	}
+
+	values := map[string]interface{}{
+		"check_limit":             c.CheckLimit,
+		"available_checks":        c.AvailableChecks,
+		"used_default_checks":     c.UsedDefault,
+		"used_transaction_checks": c.UsedTransaction,
+		"available_sms":           c.AvailableSMS,
+		"available_sms_tests":     c.AvailableSMSTests,
+		"auto_fill_sms":           c.AutoFillSMS,
+		"auto_fill_sms_amount":    c.AutoFillSMSAmount,
+		"auto_fill_sms_when_left": c.AutoFillSMSWhen,
+		"max_sms_overage":         c.MaxSMSOverage,
+		"available_rum_sites":     c.AvailableRUMSites,
+		"max_rum_filters":         c.MaxRUMFilters,
+		"max_rum_page_views":      c.MaxRUMPageViews,
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+
+	d.SetId("credits")
+	return nil
+}
@@ -0,0 +1,98 @@
+package pingdom
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+func dataSourcePingdomMaintenanceOccurrences() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePingdomMaintenanceOccurrencesRead,
+
+		Schema: map[string]*schema.Schema{
+			"maintenance_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"occurrences": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"maintenance_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"from": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"to": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePingdomMaintenanceOccurrencesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	params := map[string]string{}
+	if v, ok := d.GetOk("maintenance_id"); ok {
+		params["maintenanceid"] = strconv.Itoa(v.(int))
+	}
+	for _, k := range []string{"from", "to"} {
+		if v, ok := d.GetOk(k); ok {
+			t, err := time.Parse(time.RFC3339, v.(string))
+			if err != nil {
+				return fmt.Errorf("Error parsing %s: %s", k, err)
+			}
+			params[k] = strconv.FormatInt(t.Unix(), 10)
+		}
+	}
+
+	occurrences, err := listMaintenanceOccurrences(client, params)
+	if err != nil {
+		return fmt.Errorf("Error retrieving maintenance occurrences: %s", err)
+	}
+
+	result := []map[string]interface{}{}
+	for _, occurrence := range occurrences {
+		result = append(result, map[string]interface{}{
+			"id":             occurrence.ID,
+			"maintenance_id": occurrence.MaintenanceID,
+			"from":           unixToRFC3339(occurrence.From),
+			"to":             unixToRFC3339(occurrence.To),
+		})
+	}
+	if err := d.Set("occurrences", result); err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(fmt.Sprintf("%s-%s-%s", params["maintenanceid"], params["from"], params["to"]))))
+	return nil
+}
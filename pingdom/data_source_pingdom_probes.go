@@ -0,0 +1,146 @@
+package pingdom
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+// probeRegionRegexp matches a probe region, either on its own or written as
+// a probe filter.
+var probeRegionRegexp = regexp.MustCompile(`^(region: ?)?(NA|EU|APAC|LATAM)$`)
+
+func dataSourcePingdomProbes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePingdomProbesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(probeRegionRegexp, "must be one of NA, EU, APAC or LATAM, optionally in the format region:NA"),
+			},
+			"ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ipv6s": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"probes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipv6": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hostname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"country": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"country_iso": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"city": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"active": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePingdomProbesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	region := ""
+	if v, ok := d.GetOk("region"); ok {
+		region = probeRegionRegexp.FindStringSubmatch(v.(string))[2]
+	}
+
+	probes, err := client.Probes.List()
+	if err != nil {
+		return fmt.Errorf("Error retrieving list of probes: %s", err)
+	}
+	sort.Slice(probes, func(i, j int) bool { return probes[i].ID < probes[j].ID })
+
+	ips := []string{}
+	ipv6s := []string{}
+	result := []map[string]interface{}{}
+	for _, probe := range probes {
+		if region != "" && !strings.EqualFold(probe.Region, region) {
+			continue
+		}
+
+		if probe.IP != "" {
+			ips = append(ips, probe.IP)
+		}
+		if probe.IPv6 != "" {
+			ipv6s = append(ipv6s, probe.IPv6)
+		}
+		result = append(result, map[string]interface{}{
+			"id":          probe.ID,
+			"name":        probe.Name,
+			"ip":          probe.IP,
+			"ipv6":        probe.IPv6,
+			"hostname":    probe.Hostname,
+			"country":     probe.Country,
+			"country_iso": probe.CountryISO,
+			"city":        probe.City,
+			"region":      probe.Region,
+			"active":      probe.Active,
+		})
+	}
+
+	if err := d.Set("ips", ips); err != nil {
+		return err
+	}
+	if err := d.Set("ipv6s", ipv6s); err != nil {
+		return err
+	}
+	if err := d.Set("probes", result); err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String("probes-" + region)))
+	return nil
+}
@@ -0,0 +1,200 @@
+package pingdom
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+func referenceDescriptionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePingdomReference() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePingdomReferenceRead,
+
+		Schema: map[string]*schema.Schema{
+			"regions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"country_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"datetime_format_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"number_format_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"timezone_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"countries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"iso": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"phone_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"timezones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     referenceDescriptionSchema(),
+			},
+			"datetime_formats": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     referenceDescriptionSchema(),
+			},
+			"number_formats": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     referenceDescriptionSchema(),
+			},
+			"phone_providers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"probe_regions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func flattenReferenceDescriptions(descriptions []referenceDescription) []map[string]interface{} {
+	result := []map[string]interface{}{}
+	for _, description := range descriptions {
+		result = append(result, map[string]interface{}{
+			"id":          description.ID,
+			"description": description.Description,
+		})
+	}
+	return result
+}
+
+func dataSourcePingdomReferenceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	ref, err := readReference(client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving reference data: %s", err)
+	}
+
+	regions := []map[string]interface{}{}
+	for _, region := range ref.Regions {
+		regions = append(regions, map[string]interface{}{
+			"id":                 region.ID,
+			"description":        region.Description,
+			"country_id":         region.CountryID,
+			"datetime_format_id": region.DatetimeFormatID,
+			"number_format_id":   region.NumberFormatID,
+			"timezone_id":        region.TimezoneID,
+		})
+	}
+	if err := d.Set("regions", regions); err != nil {
+		return err
+	}
+
+	phoneCodes := map[int]referencePhoneCode{}
+	for _, phoneCode := range ref.PhoneCodes {
+		phoneCodes[phoneCode.CountryID] = phoneCode
+	}
+	countries := []map[string]interface{}{}
+	for _, country := range ref.Countries {
+		countries = append(countries, map[string]interface{}{
+			"id":         country.ID,
+			"iso":        country.ISO,
+			"name":       phoneCodes[country.ID].Name,
+			"phone_code": phoneCodes[country.ID].PhoneCode,
+		})
+	}
+	if err := d.Set("countries", countries); err != nil {
+		return err
+	}
+
+	if err := d.Set("timezones", flattenReferenceDescriptions(ref.Timezones)); err != nil {
+		return err
+	}
+	if err := d.Set("datetime_formats", flattenReferenceDescriptions(ref.DatetimeFormats)); err != nil {
+		return err
+	}
+	if err := d.Set("number_formats", flattenReferenceDescriptions(ref.NumberFormats)); err != nil {
+		return err
+	}
+
+	if err := d.Set("phone_providers", ref.PhoneProviders); err != nil {
+		return err
+	}
+
+	probes, err := client.Probes.List()
+	if err != nil {
+		return fmt.Errorf("Error retrieving list of probes: %s", err)
+	}
+	seen := map[string]bool{}
+	probeRegions := []string{}
+	for _, probe := range probes {
+		if probe.Region != "" && !seen[probe.Region] {
+			seen[probe.Region] = true
+			probeRegions = append(probeRegions, probe.Region)
+		}
+	}
+	sort.Strings(probeRegions)
+	if err := d.Set("probe_regions", probeRegions); err != nil {
+		return err
+	}
+
+	d.SetId("reference")
+	return nil
+}
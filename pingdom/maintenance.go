@@ -0,0 +1,81 @@
+package pingdom
+
+import (
+	"strconv"
+
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+// updateMaintenance replaces the maintenance window with the given ID. Unlike
+// go-pingdom's Update, the check IDs, repeat interval and recurrence end are
+// always sent, so they can be cleared. An unset recurrence end is sent as the
+// end of the window, which is what the API defaults it to.
+func updateMaintenance(client *pingdom.Client, id int, maintenance *pingdom.MaintenanceWindow) error {
+	if err := maintenance.Valid(); err != nil {
+		return err
+	}
+
+	params := maintenance.PutParams()
+	params["uptimeids"] = maintenance.UptimeIDs
+	params["tmsids"] = maintenance.TmsIDs
+	params["repeatevery"] = strconv.Itoa(maintenance.RepeatEvery)
+	if maintenance.EffectiveTo != 0 {
+		params["effectiveto"] = strconv.Itoa(maintenance.EffectiveTo)
+	} else {
+		params["effectiveto"] = strconv.FormatInt(maintenance.To, 10)
+	}
+
+	return doRequest(client, "PUT", "/maintenance/"+strconv.Itoa(id), params, &pingdom.PingdomResponse{})
+}
+
+// maintenanceOccurrence is a single occurrence of a maintenance window.
+type maintenanceOccurrence struct {
+	ID            int   `json:"id"`
+	MaintenanceID int   `json:"maintenanceid"`
+	From          int64 `json:"from"`
+	To            int64 `json:"to"`
+}
+
+type maintenanceOccurrencesJSONResponse struct {
+	Occurrences []maintenanceOccurrence `json:"occurrences"`
+}
+
+type maintenanceOccurrenceJSONResponse struct {
+	Occurrence maintenanceOccurrence `json:"occurrence"`
+}
+
+// listMaintenanceOccurrences returns the maintenance occurrences matching the
+// given query parameters, such as maintenanceid, from and to.
+func listMaintenanceOccurrences(client *pingdom.Client, params map[string]string) ([]maintenanceOccurrence, error) {
+	m := &maintenanceOccurrencesJSONResponse{}
+	if err := doRequest(client, "GET", "/maintenance.occurrences", params, m); err != nil {
+		return nil, err
+	}
+	return m.Occurrences, nil
+}
+
+// readMaintenanceOccurrence returns the maintenance occurrence with the given
+// ID.
+func readMaintenanceOccurrence(client *pingdom.Client, id int) (*maintenanceOccurrence, error) {
+	m := &maintenanceOccurrenceJSONResponse{}
+	if err := doRequest(client, "GET", "/maintenance.occurrences/"+strconv.Itoa(id), nil, m); err != nil {
+		return nil, err
+	}
+	return &m.Occurrence, nil
+}
+
+// updateMaintenanceOccurrence moves the start and end of the maintenance
+// occurrence with the given ID.
+func updateMaintenanceOccurrence(client *pingdom.Client, id int, from int64, to int64) error {
+	params := map[string]string{
+		"from": strconv.FormatInt(from, 10),
+		"to":   strconv.FormatInt(to, 10),
+	}
+	return doRequest(client, "PUT", "/maintenance.occurrences/"+strconv.Itoa(id), params, &pingdom.PingdomResponse{})
+}
+
+// deleteMaintenanceOccurrence deletes the maintenance occurrence with the
+// given ID, leaving the rest of the window untouched.
+func deleteMaintenanceOccurrence(client *pingdom.Client, id int) error {
+	return doRequest(client, "DELETE", "/maintenance.occurrences/"+strconv.Itoa(id), nil, &pingdom.PingdomResponse{})
+}
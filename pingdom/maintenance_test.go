@@ -0,0 +1,41 @@
+package pingdom
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+func TestUpdateMaintenanceClearsFields(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(`{"message":"Maintenance window successfully modified!"}`))
+	}))
+	defer server.Close()
+
+	client, err := pingdom.NewClientWithConfig(pingdom.ClientConfig{APIToken: "token", BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err)
+	}
+
+	maintenance := &pingdom.MaintenanceWindow{Description: "deploy", From: 1000, To: 2000}
+	if err := updateMaintenance(client, 1, maintenance); err != nil {
+		t.Fatalf("updateMaintenance: %s", err)
+	}
+
+	expected := map[string]string{
+		"uptimeids":   "",
+		"tmsids":      "",
+		"repeatevery": "0",
+		"effectiveto": "2000",
+	}
+	for k, v := range expected {
+		if _, ok := query[k]; !ok || query.Get(k) != v {
+			t.Errorf("expected %s=%q, got %q (present: %v)", k, v, query.Get(k), ok)
+		}
+	}
+}
@@ -17,13 +17,30 @@ func Provider() terraform.ResourceProvider {
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"pingdom_check":   resourcePingdomCheck(),
-			"pingdom_team":    resourcePingdomTeam(),
-			"pingdom_contact": resourcePingdomContact(),
+			"pingdom_check":                  resourcePingdomCheck(),
+			"pingdom_http_check":             resourcePingdomHTTPCheck(),
+			"pingdom_tcp_check":              resourcePingdomTCPCheck(),
+			"pingdom_ping_check":             resourcePingdomPingCheck(),
+			"pingdom_transaction_check":      resourcePingdomTransactionCheck(),
+			"pingdom_maintenance":            resourcePingdomMaintenance(),
+			"pingdom_maintenance_occurrence": resourcePingdomMaintenanceOccurrence(),
+			"pingdom_team":                   resourcePingdomTeam(),
+			"pingdom_contact":                resourcePingdomContact(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"pingdom_contact": dataSourcePingdomContact(),
-			"pingdom_team":    dataSourcePingdomTeam(),
+			"pingdom_contact":                 dataSourcePingdomContact(),
+			"pingdom_check":                   dataSourcePingdomCheck(),
+			"pingdom_checks":                  dataSourcePingdomChecks(),
+			"pingdom_probes":                  dataSourcePingdomProbes(),
+			"pingdom_check_uptime":            dataSourcePingdomCheckUptime(),
+			"pingdom_check_outages":           dataSourcePingdomCheckOutages(),
+			"pingdom_check_performance":       dataSourcePingdomCheckPerformance(),
+			"pingdom_check_results":           dataSourcePingdomCheckResults(),
+			"pingdom_alerts":                  dataSourcePingdomAlerts(),
+			"pingdom_credits":                 dataSourcePingdomCredits(),
+			"pingdom_reference":               dataSourcePingdomReference(),
+			"pingdom_team":                    dataSourcePingdomTeam(),
+			"pingdom_maintenance_occurrences": dataSourcePingdomMaintenanceOccurrences(),
 		},
 		ConfigureFunc: providerConfigure,
 	}
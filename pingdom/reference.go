@@ -0,0 +1,54 @@
+package pingdom
+
+import (
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+// reference holds the reference data of the Pingdom API, as returned by
+// /reference.
+type reference struct {
+	Regions         []referenceRegion      `json:"regions"`
+	Timezones       []referenceDescription `json:"timezones"`
+	DatetimeFormats []referenceDescription `json:"datetimeformats"`
+	NumberFormats   []referenceDescription `json:"numberformats"`
+	Countries       []referenceCountry     `json:"countries"`
+	PhoneCodes      []referencePhoneCode   `json:"phonecodes"`
+	PhoneProviders  []string               `json:"phoneproviders"`
+}
+
+// referenceRegion is a locale region, with its default country, timezone and
+// formats.
+type referenceRegion struct {
+	ID               int    `json:"id"`
+	Description      string `json:"description"`
+	CountryID        int    `json:"countryid"`
+	DatetimeFormatID int    `json:"datetimeformatid"`
+	NumberFormatID   int    `json:"numberformatid"`
+	TimezoneID       int    `json:"timezoneid"`
+}
+
+// referenceDescription is a timezone, datetime format or number format.
+type referenceDescription struct {
+	ID          int    `json:"id"`
+	Description string `json:"description"`
+}
+
+type referenceCountry struct {
+	ID  int    `json:"id"`
+	ISO string `json:"iso"`
+}
+
+type referencePhoneCode struct {
+	CountryID int    `json:"countryid"`
+	Name      string `json:"name"`
+	PhoneCode string `json:"phonecode"`
+}
+
+// readReference returns the reference data of the Pingdom API.
+func readReference(client *pingdom.Client) (*reference, error) {
+	m := &reference{}
+	if err := doRequest(client, "GET", "/reference", nil, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
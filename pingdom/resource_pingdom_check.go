@@ -3,11 +3,15 @@ package pingdom
 import (
 	"fmt"
 	"log"
+	"net/url"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/russellcardullo/go-pingdom/pingdom"
 )
 
@@ -21,89 +25,80 @@ func resourcePingdomCheck() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: false,
-			},
+		CustomizeDiff: resourcePingdomCheckCustomizeDiff,
 
-			"host": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: false,
+		SchemaVersion: 2,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourcePingdomCheckV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourcePingdomCheckStateUpgradeV0,
+				Version: 0,
 			},
-
-			"type": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+			{
+				Type:    resourcePingdomCheckV1().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourcePingdomCheckStateUpgradeV1,
+				Version: 1,
 			},
+		},
 
-			"paused": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				ForceNew: false,
+		Schema: mergeSchemas(commonCheckSchema(), map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"http", "httpcustom", "ping", "tcp", "udp", "dns", "smtp", "pop3", "imap"}, false),
 			},
 
 			"responsetime_threshold": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				ForceNew: false,
-				Computed: true,
-			},
-
-			"resolution": {
-				Type:     schema.TypeInt,
-				Required: true,
-				ForceNew: false,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     false,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 30000),
 			},
 
-			"sendnotificationwhendown": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				ForceNew: false,
-				Computed: true,
-			},
-
-			"notifyagainevery": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				ForceNew: false,
-			},
-
-			"notifywhenbackup": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				ForceNew: false,
-				Computed: true,
+			// host is optional here as it may be derived from target_url. It is
+			// not computed, so a missing host is caught at plan time.
+			"host": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      false,
+				ValidateFunc:  validateCheckHost,
+				ConflictsWith: []string{"target_url"},
 			},
 
-			"integrationids": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				ForceNew: false,
-				Elem:     &schema.Schema{Type: schema.TypeInt},
+			"target_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      false,
+				ValidateFunc:  validateTargetURL,
+				ConflictsWith: []string{"host", "url", "port", "encryption"},
 			},
 
 			"encryption": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				ForceNew: false,
+				Type:             schema.TypeBool,
+				Optional:         true,
+				ForceNew:         false,
+				DiffSuppressFunc: suppressWithTargetURL,
+				ConflictsWith:    []string{"target_url"},
 			},
 
 			"url": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: false,
-				Default:  "/",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         false,
+				Default:          "/",
+				DiffSuppressFunc: suppressWithTargetURL,
+				ConflictsWith:    []string{"target_url"},
 			},
 
 			"port": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				ForceNew: false,
-				Computed: true,
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      false,
+				Computed:      true,
+				ValidateFunc:  validation.IntBetween(1, 65535),
+				ConflictsWith: []string{"target_url"},
 			},
 
 			"username": {
@@ -141,48 +136,314 @@ func resourcePingdomCheck() *schema.Resource {
 				Optional: true,
 				ForceNew: false,
 			},
-			"tags": {
-				Type:     schema.TypeString,
+
+			"verify_certificate": {
+				Type:     schema.TypeBool,
 				Optional: true,
 				ForceNew: false,
-				StateFunc: func(val interface{}) string {
-					return sortString(val.(string), ",")
-				},
+				Computed: true,
 			},
 
-			"probefilters": {
+			"ssl_down_days_before": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     false,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"stringtosend": {
 				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: false,
 			},
 
-			"userids": {
-				Type:     schema.TypeSet,
+			"stringtoexpect": {
+				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: false,
-				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
 
-			"teamids": {
-				Type:     schema.TypeSet,
+			"nameserver": {
+				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: false,
-				Elem:     &schema.Schema{Type: schema.TypeInt},
 			},
 
-			"stringtosend": {
+			"expectedip": {
 				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: false,
 			},
 
-			"stringtoexpect": {
-				Type:     schema.TypeString,
+			"additionalurls": {
+				Type:     schema.TypeList,
 				Optional: true,
 				ForceNew: false,
+				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+		}),
+	}
+}
+
+// commonCheckSchema returns the attributes shared by every uptime check
+// resource.
+func commonCheckSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: false,
+		},
+
+		"host": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     false,
+			ValidateFunc: validateCheckHost,
+		},
+
+		"paused": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			ForceNew: false,
+		},
+
+		"resolution": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ForceNew:     false,
+			ValidateFunc: validation.IntInSlice([]int{1, 5, 15, 30, 60}),
+		},
+
+		"sendnotificationwhendown": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ForceNew:     false,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(0, 60),
+		},
+
+		"notifyagainevery": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ForceNew:     false,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+
+		"notifywhenbackup": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			ForceNew: false,
+			Computed: true,
+		},
+
+		"integrationids": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			ForceNew: false,
+			Elem:     &schema.Schema{Type: schema.TypeInt},
+		},
+
+		"tags": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			ForceNew: false,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^,\s]+$`), "must not contain commas or whitespace"),
+			},
+		},
+
+		"probefilters": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			ForceNew: false,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringMatch(probeFilterRegexp, "must be in the format region:NA, one of NA, EU, APAC or LATAM"),
+			},
+			Set: func(v interface{}) int {
+				return hashcode.String(normaliseProbeFilter(v.(string)))
+			},
+		},
+
+		"userids": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			ForceNew: false,
+			Elem:     &schema.Schema{Type: schema.TypeInt},
+		},
+
+		"custom_message": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: false,
+		},
+
+		"ipv6": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			ForceNew: false,
 		},
+
+		"teamids": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			ForceNew: false,
+			Elem:     &schema.Schema{Type: schema.TypeInt},
+		},
+	}
+}
+
+// probeFilterRegexp matches a probe filter as accepted by the Pingdom API. The
+// API returns filters as "region: NA", which is normalised on read.
+var probeFilterRegexp = regexp.MustCompile(`^region: ?(NA|EU|APAC|LATAM)$`)
+
+// normaliseProbeFilter removes the space the Pingdom API puts into probe
+// filters: "region: NA" -> "region:NA".
+func normaliseProbeFilter(filter string) string {
+	return strings.Replace(filter, ": ", ":", 1)
+}
+
+// validateCheckHost ensures a check host is a bare hostname, since the
+// scheme, port and path of a check are configured separately.
+func validateCheckHost(v interface{}, k string) (ws []string, errors []error) {
+	host := v.(string)
+	if strings.Contains(host, "://") {
+		errors = append(errors, fmt.Errorf("%q must not contain a scheme, got: %s", k, host))
+	} else if strings.ContainsAny(host, "/?#") {
+		errors = append(errors, fmt.Errorf("%q must not contain a path, got: %s", k, host))
 	}
+	return
+}
+
+// validateTargetURL ensures target_url is an absolute http or https URL.
+func validateTargetURL(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := parseTargetURL(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q %s", k, err))
+	}
+	return
+}
+
+// suppressWithTargetURL suppresses diffs on the attributes derived from
+// target_url while it is set.
+func suppressWithTargetURL(k, old, new string, d *schema.ResourceData) bool {
+	return d.Get("target_url").(string) != ""
+}
+
+// targetURL is a target_url decomposed into the HTTP check attributes.
+type targetURL struct {
+	Hostname   string
+	Url        string
+	Port       int
+	Encryption bool
+}
+
+// parseTargetURL decomposes a URL such as https://example.com:8443/health
+// into the host, url, port and encryption attributes of an HTTP check. The
+// port is 0 unless the URL names one explicitly.
+func parseTargetURL(raw string) (*targetURL, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("must be a valid URL: %s", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("must use the http or https scheme, got: %s", raw)
+	}
+	if u.Hostname() == "" {
+		return nil, fmt.Errorf("must contain a host, got: %s", raw)
+	}
+
+	target := &targetURL{
+		Hostname:   u.Hostname(),
+		Url:        u.RequestURI(),
+		Encryption: u.Scheme == "https",
+	}
+	if p := u.Port(); p != "" {
+		port, err := strconv.Atoi(p)
+		if err != nil {
+			return nil, fmt.Errorf("must contain a valid port, got: %s", raw)
+		}
+		target.Port = port
+	}
+
+	return target, nil
+}
+
+// equals reports whether two targets address the same URL, treating an
+// unset port as the default port of the scheme.
+func (t *targetURL) equals(other *targetURL) bool {
+	return t.Hostname == other.Hostname && t.Url == other.Url &&
+		t.Encryption == other.Encryption && t.port() == other.port()
+}
+
+func (t *targetURL) port() int {
+	if t.Port != 0 {
+		return t.Port
+	}
+	return t.defaultPort()
+}
+
+func (t *targetURL) defaultPort() int {
+	if t.Encryption {
+		return 443
+	}
+	return 80
+}
+
+// String returns the target as a URL, leaving out default ports.
+func (t *targetURL) String() string {
+	u := url.URL{Scheme: "http", Host: t.Hostname}
+	if t.Encryption {
+		u.Scheme = "https"
+	}
+	if t.port() != t.defaultPort() {
+		u.Host = fmt.Sprintf("%s:%d", t.Hostname, t.Port)
+	}
+	return u.String() + t.Url
+}
+
+func resourcePingdomCheckCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if v := d.Get("target_url").(string); v != "" {
+		if checkType := d.Get("type").(string); checkType != "http" {
+			return fmt.Errorf("target_url is only supported for http checks, not %s checks", checkType)
+		}
+	} else if d.NewValueKnown("host") && d.NewValueKnown("target_url") && d.Get("host").(string) == "" {
+		return fmt.Errorf("one of host or target_url must be set")
+	}
+
+	// Replacing a check frees its credit. The replacement is then planned
+	// again without prior state, which reserves a credit for the new check.
+	if client, ok := meta.(*pingdom.Client); ok && client != nil && d.Id() != "" && d.HasChange("type") {
+		releaseCheckCredit(client)
+	}
+
+	return reserveCheckCreditForDiff(d, meta)
+}
+
+// reserveCheckCreditForDiff reserves a check credit for a check planned for
+// creation. CustomizeDiff only runs when planning, once for each new check, so
+// every call is counted.
+func reserveCheckCreditForDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+	client, ok := meta.(*pingdom.Client)
+	if !ok || client == nil {
+		return nil
+	}
+	return reserveCheckCredit(client)
+}
+
+func mergeSchemas(schemas ...map[string]*schema.Schema) map[string]*schema.Schema {
+	merged := map[string]*schema.Schema{}
+	for _, m := range schemas {
+		for k, v := range m {
+			merged[k] = v
+		}
+	}
+	return merged
 }
 
 type commonCheckParams struct {
@@ -210,15 +471,36 @@ type commonCheckParams struct {
 	ProbeFilters             string
 	StringToSend             string
 	StringToExpect           string
+	NameServer               string
+	ExpectedIP               string
+	AdditionalUrls           []string
+	VerifyCertificate        *bool
+	SSLDownDaysBefore        *int
+	CustomMessage            string
+	IPv6                     bool
 }
 
-func sortString(input string, seperator string) string {
-	list := strings.Split(input, seperator)
-	sort.Strings(list)
-	return strings.Join(list, seperator)
+// splitAuth splits the "username:password" auth string returned by the
+// Pingdom API.
+func splitAuth(auth string) (string, string) {
+	if auth == "" {
+		return "", ""
+	}
+	parts := strings.SplitN(auth, ":", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
 }
 
 func checkForResource(d *schema.ResourceData) (pingdom.Check, error) {
+	return checkForType(d, d.Get("type").(string))
+}
+
+// checkForType builds a check of the given type from the resource data. It
+// is shared by all check resources; attributes that are not part of a
+// resource's schema are simply left empty.
+func checkForType(d *schema.ResourceData, checkType string) (pingdom.Check, error) {
 	checkParams := commonCheckParams{}
 
 	// required
@@ -319,12 +601,24 @@ func checkForResource(d *schema.ResourceData) (pingdom.Check, error) {
 		}
 	}
 	if v, ok := d.GetOk("tags"); ok {
-		// Sort alphabetically before contionuing
-		checkParams.Tags = sortString(v.(string), ",")
+		interfaceSlice := v.(*schema.Set).List()
+		var stringSlice []string
+		for i := range interfaceSlice {
+			stringSlice = append(stringSlice, interfaceSlice[i].(string))
+		}
+		// Sort alphabetically so the API sees a stable value
+		sort.Strings(stringSlice)
+		checkParams.Tags = strings.Join(stringSlice, ",")
 	}
 
 	if v, ok := d.GetOk("probefilters"); ok {
-		checkParams.ProbeFilters = v.(string)
+		interfaceSlice := v.(*schema.Set).List()
+		var stringSlice []string
+		for i := range interfaceSlice {
+			stringSlice = append(stringSlice, normaliseProbeFilter(interfaceSlice[i].(string)))
+		}
+		sort.Strings(stringSlice)
+		checkParams.ProbeFilters = strings.Join(stringSlice, ",")
 	}
 
 	if v, ok := d.GetOk("stringtosend"); ok {
@@ -335,7 +629,66 @@ func checkForResource(d *schema.ResourceData) (pingdom.Check, error) {
 		checkParams.StringToExpect = v.(string)
 	}
 
-	checkType := d.Get("type")
+	if v, ok := d.GetOk("nameserver"); ok {
+		checkParams.NameServer = v.(string)
+	}
+
+	if v, ok := d.GetOk("expectedip"); ok {
+		checkParams.ExpectedIP = v.(string)
+	}
+
+	if v, ok := d.GetOkExists("verify_certificate"); ok {
+		verifyCertificate := v.(bool)
+		checkParams.VerifyCertificate = &verifyCertificate
+	}
+
+	if v, ok := d.GetOkExists("ssl_down_days_before"); ok {
+		sslDownDaysBefore := v.(int)
+		checkParams.SSLDownDaysBefore = &sslDownDaysBefore
+	}
+
+	if v, ok := d.GetOk("additionalurls"); ok {
+		interfaceSlice := v.([]interface{})
+		var stringSlice []string
+		for i := range interfaceSlice {
+			stringSlice = append(stringSlice, interfaceSlice[i].(string))
+		}
+		checkParams.AdditionalUrls = stringSlice
+	}
+
+	if v, ok := d.GetOk("target_url"); ok {
+		target, err := parseTargetURL(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		checkParams.Hostname = target.Hostname
+		checkParams.Url = target.Url
+		checkParams.Port = target.port()
+		checkParams.Encryption = target.Encryption
+	}
+
+	if v, ok := d.GetOk("custom_message"); ok {
+		checkParams.CustomMessage = v.(string)
+	}
+
+	if v, ok := d.GetOk("ipv6"); ok {
+		checkParams.IPv6 = v.(bool)
+	}
+
+	check, err := newCheck(checkType, checkParams)
+	if err != nil {
+		return nil, err
+	}
+
+	return &extendedCheck{
+		Check:         check,
+		CustomMessage: checkParams.CustomMessage,
+		IPv6:          checkParams.IPv6,
+	}, nil
+}
+
+// newCheck returns the go-pingdom or local check type for the given type.
+func newCheck(checkType string, checkParams commonCheckParams) (pingdom.Check, error) {
 	switch checkType {
 	case "http":
 		return &pingdom.HttpCheck{
@@ -361,6 +714,8 @@ func checkForResource(d *schema.ResourceData) (pingdom.Check, error) {
 			ProbeFilters:             checkParams.ProbeFilters,
 			UserIds:                  checkParams.UserIds,
 			TeamIds:                  checkParams.TeamIds,
+			VerifyCertificate:        checkParams.VerifyCertificate,
+			SSLDownDaysBefore:        checkParams.SSLDownDaysBefore,
 		}, nil
 	case "ping":
 		return &pingdom.PingCheck{
@@ -396,6 +751,118 @@ func checkForResource(d *schema.ResourceData) (pingdom.Check, error) {
 			StringToSend:             checkParams.StringToSend,
 			StringToExpect:           checkParams.StringToExpect,
 		}, nil
+	case "dns":
+		return &dnsCheck{
+			Name:                     checkParams.Name,
+			Hostname:                 checkParams.Hostname,
+			Resolution:               checkParams.Resolution,
+			Paused:                   checkParams.Paused,
+			SendNotificationWhenDown: checkParams.SendNotificationWhenDown,
+			NotifyAgainEvery:         checkParams.NotifyAgainEvery,
+			NotifyWhenBackup:         checkParams.NotifyWhenBackup,
+			IntegrationIds:           checkParams.IntegrationIds,
+			Tags:                     checkParams.Tags,
+			ProbeFilters:             checkParams.ProbeFilters,
+			UserIds:                  checkParams.UserIds,
+			TeamIds:                  checkParams.TeamIds,
+			NameServer:               checkParams.NameServer,
+			ExpectedIP:               checkParams.ExpectedIP,
+		}, nil
+	case "udp":
+		return &udpCheck{
+			Name:                     checkParams.Name,
+			Hostname:                 checkParams.Hostname,
+			Resolution:               checkParams.Resolution,
+			Paused:                   checkParams.Paused,
+			SendNotificationWhenDown: checkParams.SendNotificationWhenDown,
+			NotifyAgainEvery:         checkParams.NotifyAgainEvery,
+			NotifyWhenBackup:         checkParams.NotifyWhenBackup,
+			IntegrationIds:           checkParams.IntegrationIds,
+			Tags:                     checkParams.Tags,
+			ProbeFilters:             checkParams.ProbeFilters,
+			UserIds:                  checkParams.UserIds,
+			TeamIds:                  checkParams.TeamIds,
+			Port:                     checkParams.Port,
+			StringToSend:             checkParams.StringToSend,
+			StringToExpect:           checkParams.StringToExpect,
+		}, nil
+	case "smtp":
+		return &smtpCheck{
+			Name:                     checkParams.Name,
+			Hostname:                 checkParams.Hostname,
+			Resolution:               checkParams.Resolution,
+			Paused:                   checkParams.Paused,
+			SendNotificationWhenDown: checkParams.SendNotificationWhenDown,
+			NotifyAgainEvery:         checkParams.NotifyAgainEvery,
+			NotifyWhenBackup:         checkParams.NotifyWhenBackup,
+			IntegrationIds:           checkParams.IntegrationIds,
+			Tags:                     checkParams.Tags,
+			ProbeFilters:             checkParams.ProbeFilters,
+			UserIds:                  checkParams.UserIds,
+			TeamIds:                  checkParams.TeamIds,
+			Port:                     checkParams.Port,
+			Encryption:               checkParams.Encryption,
+			StringToExpect:           checkParams.StringToExpect,
+			Username:                 checkParams.Username,
+			Password:                 checkParams.Password,
+		}, nil
+	case "pop3":
+		return &pop3Check{
+			Name:                     checkParams.Name,
+			Hostname:                 checkParams.Hostname,
+			Resolution:               checkParams.Resolution,
+			Paused:                   checkParams.Paused,
+			SendNotificationWhenDown: checkParams.SendNotificationWhenDown,
+			NotifyAgainEvery:         checkParams.NotifyAgainEvery,
+			NotifyWhenBackup:         checkParams.NotifyWhenBackup,
+			IntegrationIds:           checkParams.IntegrationIds,
+			Tags:                     checkParams.Tags,
+			ProbeFilters:             checkParams.ProbeFilters,
+			UserIds:                  checkParams.UserIds,
+			TeamIds:                  checkParams.TeamIds,
+			Port:                     checkParams.Port,
+			Encryption:               checkParams.Encryption,
+			StringToExpect:           checkParams.StringToExpect,
+		}, nil
+	case "imap":
+		return &imapCheck{
+			Name:                     checkParams.Name,
+			Hostname:                 checkParams.Hostname,
+			Resolution:               checkParams.Resolution,
+			Paused:                   checkParams.Paused,
+			SendNotificationWhenDown: checkParams.SendNotificationWhenDown,
+			NotifyAgainEvery:         checkParams.NotifyAgainEvery,
+			NotifyWhenBackup:         checkParams.NotifyWhenBackup,
+			IntegrationIds:           checkParams.IntegrationIds,
+			Tags:                     checkParams.Tags,
+			ProbeFilters:             checkParams.ProbeFilters,
+			UserIds:                  checkParams.UserIds,
+			TeamIds:                  checkParams.TeamIds,
+			Port:                     checkParams.Port,
+			Encryption:               checkParams.Encryption,
+			StringToExpect:           checkParams.StringToExpect,
+		}, nil
+	case "httpcustom":
+		return &httpCustomCheck{
+			Name:                     checkParams.Name,
+			Hostname:                 checkParams.Hostname,
+			Resolution:               checkParams.Resolution,
+			Paused:                   checkParams.Paused,
+			SendNotificationWhenDown: checkParams.SendNotificationWhenDown,
+			NotifyAgainEvery:         checkParams.NotifyAgainEvery,
+			NotifyWhenBackup:         checkParams.NotifyWhenBackup,
+			IntegrationIds:           checkParams.IntegrationIds,
+			Tags:                     checkParams.Tags,
+			ProbeFilters:             checkParams.ProbeFilters,
+			UserIds:                  checkParams.UserIds,
+			TeamIds:                  checkParams.TeamIds,
+			Url:                      checkParams.Url,
+			Encryption:               checkParams.Encryption,
+			Port:                     checkParams.Port,
+			Username:                 checkParams.Username,
+			Password:                 checkParams.Password,
+			AdditionalUrls:           checkParams.AdditionalUrls,
+		}, nil
 	default:
 		return nil, fmt.Errorf("unknown type for check '%v'", checkType)
 	}
@@ -424,13 +891,171 @@ func resourcePingdomCheckCreate(d *schema.ResourceData, meta interface{}) error
 func resourcePingdomCheckRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*pingdom.Client)
 
+	ck, details, err := readCheckForResource(d, client)
+	if err != nil {
+		return err
+	}
+	if ck == nil {
+		return nil
+	}
+
+	return updateResourceFromCheck(d, ck, details)
+}
+
+// updateResourceFromCheck sets every attribute of a pingdom_check from a check
+// of any supported type.
+func updateResourceFromCheck(d *schema.ResourceData, ck *pingdom.CheckResponse, details *checkDetails) error {
+	if err := updateResourceFromCheckResponse(d, ck, details); err != nil {
+		return err
+	}
+
+	if err := d.Set("responsetime_threshold", ck.ResponseTimeThreshold); err != nil {
+		return err
+	}
+
+	if ck.Type.HTTP != nil {
+		if err := d.Set("type", "http"); err != nil {
+			return err
+		}
+		if err := updateResourceFromHTTPDetails(d, ck.Type.HTTP); err != nil {
+			return err
+		}
+
+		// Only rewrite target_url when it no longer matches the check, so
+		// equivalent spellings of the same URL do not cause a diff.
+		if v := d.Get("target_url").(string); v != "" {
+			actual := &targetURL{
+				Hostname:   ck.Hostname,
+				Url:        ck.Type.HTTP.Url,
+				Port:       ck.Type.HTTP.Port,
+				Encryption: ck.Type.HTTP.Encryption,
+			}
+			if current, err := parseTargetURL(v); err != nil || !current.equals(actual) {
+				if err := d.Set("target_url", actual.String()); err != nil {
+					return err
+				}
+			}
+		}
+	} else if ck.Type.TCP != nil {
+		if err := d.Set("type", "tcp"); err != nil {
+			return err
+		}
+		if err := updateResourceFromTCPDetails(d, ck.Type.TCP); err != nil {
+			return err
+		}
+	} else if details.Type.DNS != nil {
+		if err := d.Set("type", "dns"); err != nil {
+			return err
+		}
+		if err := d.Set("nameserver", details.Type.DNS.NameServer); err != nil {
+			return err
+		}
+		if err := d.Set("expectedip", details.Type.DNS.ExpectedIP); err != nil {
+			return err
+		}
+	} else if details.Type.UDP != nil {
+		if err := d.Set("type", "udp"); err != nil {
+			return err
+		}
+		if err := d.Set("port", details.Type.UDP.Port); err != nil {
+			return err
+		}
+		if err := d.Set("stringtosend", details.Type.UDP.StringToSend); err != nil {
+			return err
+		}
+		if err := d.Set("stringtoexpect", details.Type.UDP.StringToExpect); err != nil {
+			return err
+		}
+	} else if details.Type.SMTP != nil {
+		if err := d.Set("type", "smtp"); err != nil {
+			return err
+		}
+		if err := d.Set("port", details.Type.SMTP.Port); err != nil {
+			return err
+		}
+		if err := d.Set("encryption", details.Type.SMTP.Encryption); err != nil {
+			return err
+		}
+		if err := d.Set("stringtoexpect", details.Type.SMTP.StringToExpect); err != nil {
+			return err
+		}
+		username, password := splitAuth(details.Type.SMTP.Auth)
+		if err := d.Set("username", username); err != nil {
+			return err
+		}
+		if err := d.Set("password", password); err != nil {
+			return err
+		}
+	} else if details.Type.POP3 != nil {
+		if err := d.Set("type", "pop3"); err != nil {
+			return err
+		}
+		if err := d.Set("port", details.Type.POP3.Port); err != nil {
+			return err
+		}
+		if err := d.Set("encryption", details.Type.POP3.Encryption); err != nil {
+			return err
+		}
+		if err := d.Set("stringtoexpect", details.Type.POP3.StringToExpect); err != nil {
+			return err
+		}
+	} else if details.Type.IMAP != nil {
+		if err := d.Set("type", "imap"); err != nil {
+			return err
+		}
+		if err := d.Set("port", details.Type.IMAP.Port); err != nil {
+			return err
+		}
+		if err := d.Set("encryption", details.Type.IMAP.Encryption); err != nil {
+			return err
+		}
+		if err := d.Set("stringtoexpect", details.Type.IMAP.StringToExpect); err != nil {
+			return err
+		}
+	} else if details.Type.HTTPCustom != nil {
+		if err := d.Set("type", "httpcustom"); err != nil {
+			return err
+		}
+		if err := d.Set("url", details.Type.HTTPCustom.Url); err != nil {
+			return err
+		}
+		if err := d.Set("encryption", details.Type.HTTPCustom.Encryption); err != nil {
+			return err
+		}
+		if err := d.Set("port", details.Type.HTTPCustom.Port); err != nil {
+			return err
+		}
+		username, password := splitAuth(details.Type.HTTPCustom.Auth)
+		if err := d.Set("username", username); err != nil {
+			return err
+		}
+		if err := d.Set("password", password); err != nil {
+			return err
+		}
+		if err := d.Set("additionalurls", details.Type.HTTPCustom.AdditionalUrls); err != nil {
+			return err
+		}
+	} else if ck.Type.Name == "ping" {
+		if err := d.Set("type", "ping"); err != nil {
+			return err
+		}
+	} else {
+		return fmt.Errorf("unsupported type '%s' for check %d", ck.Type.Name, ck.ID)
+	}
+
+	return nil
+}
+
+// readCheckForResource reads the check behind a resource. If the check no
+// longer exists, the resource ID is cleared and a nil check is returned.
+func readCheckForResource(d *schema.ResourceData, client *pingdom.Client) (*pingdom.CheckResponse, *checkDetails, error) {
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
-		return fmt.Errorf("Error retrieving id for resource: %s", err)
+		return nil, nil, fmt.Errorf("Error retrieving id for resource: %s", err)
 	}
 	cl, err := client.Checks.List()
 	if err != nil {
-		return fmt.Errorf("Error retrieving list of checks: %s", err)
+		return nil, nil, fmt.Errorf("Error retrieving list of checks: %s", err)
 	}
 	exists := false
 	for _, ckid := range cl {
@@ -441,15 +1066,24 @@ func resourcePingdomCheckRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	if !exists {
 		d.SetId("")
-		return nil
+		return nil, nil, nil
 	}
-	ck, err := client.Checks.Read(id)
+	ck, details, err := readCheck(client, id)
 	if err != nil {
-		return fmt.Errorf("Error retrieving check: %s", err)
+		return nil, nil, fmt.Errorf("Error retrieving check: %s", err)
 	}
 
-	if err := d.Set("host", ck.Hostname); err != nil {
-		return err
+	return ck, details, nil
+}
+
+// updateResourceFromCheckResponse sets the attributes shared by every check
+// resource.
+func updateResourceFromCheckResponse(d *schema.ResourceData, ck *pingdom.CheckResponse, details *checkDetails) error {
+	// host is left unset when it is derived from target_url.
+	if v, ok := d.GetOk("target_url"); !ok || v.(string) == "" {
+		if err := d.Set("host", ck.Hostname); err != nil {
+			return err
+		}
 	}
 
 	if err := d.Set("name", ck.Name); err != nil {
@@ -460,10 +1094,6 @@ func resourcePingdomCheckRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	if err := d.Set("responsetime_threshold", ck.ResponseTimeThreshold); err != nil {
-		return err
-	}
-
 	if err := d.Set("sendnotificationwhendown", ck.SendNotificationWhenDown); err != nil {
 		return err
 	}
@@ -476,15 +1106,19 @@ func resourcePingdomCheckRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if err := d.Set("custom_message", details.CustomMessage); err != nil {
+		return err
+	}
+
+	if err := d.Set("ipv6", details.IPv6); err != nil {
+		return err
+	}
+
 	tags := []string{}
 	for _, tag := range ck.Tags {
 		tags = append(tags, tag.Name)
 	}
-
-	// We need to sort the strings here as the pingdom API returns them sorted by
-	//number of occurances across all checks
-	sort.Strings(tags)
-	if err := d.Set("tags", strings.Join(tags, ",")); err != nil {
+	if err := d.Set("tags", tags); err != nil {
 		return err
 	}
 
@@ -527,71 +1161,75 @@ func resourcePingdomCheckRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	if probefilters := ck.ProbeFilters; len(probefilters) > 0 {
-		// normalise: "region: NA" -> "region:NA"
-		if err := d.Set("probefilters", strings.Replace(probefilters[0], ": ", ":", 1)); err != nil {
-			return err
-		}
+	probefilters := []string{}
+	for _, filter := range ck.ProbeFilters {
+		probefilters = append(probefilters, normaliseProbeFilter(filter))
+	}
+	if err := d.Set("probefilters", probefilters); err != nil {
+		return err
 	}
 
-	if ck.Type.HTTP != nil {
-		if err := d.Set("type", "http"); err != nil {
-			return err
-		}
-		if err := d.Set("responsetime_threshold", ck.ResponseTimeThreshold); err != nil {
-			return err
-		}
-		if err := d.Set("url", ck.Type.HTTP.Url); err != nil {
-			return err
-		}
-		if err := d.Set("encryption", ck.Type.HTTP.Encryption); err != nil {
-			return err
-		}
-		if err := d.Set("port", ck.Type.HTTP.Port); err != nil {
-			return err
-		}
-		if err := d.Set("username", ck.Type.HTTP.Username); err != nil {
-			return err
-		}
-		if err := d.Set("password", ck.Type.HTTP.Password); err != nil {
-			return err
-		}
-		if err := d.Set("shouldcontain", ck.Type.HTTP.ShouldContain); err != nil {
-			return err
-		}
-		if err := d.Set("shouldnotcontain", ck.Type.HTTP.ShouldNotContain); err != nil {
-			return err
-		}
-		if err := d.Set("postdata", ck.Type.HTTP.PostData); err != nil {
-			return err
-		}
+	return nil
+}
 
-		if v, ok := ck.Type.HTTP.RequestHeaders["User-Agent"]; ok {
-			if strings.HasPrefix(v, "Pingdom.com_bot_version_") {
-				delete(ck.Type.HTTP.RequestHeaders, "User-Agent")
-			}
-		}
-		if err := d.Set("requestheaders", ck.Type.HTTP.RequestHeaders); err != nil {
-			return err
-		}
-	} else if ck.Type.TCP != nil {
-		if err := d.Set("type", "tcp"); err != nil {
-			return err
-		}
-		if err := d.Set("port", ck.Type.TCP.Port); err != nil {
-			return err
-		}
-		if err := d.Set("stringtosend", ck.Type.TCP.StringToSend); err != nil {
-			return err
-		}
-		if err := d.Set("stringtoexpect", ck.Type.TCP.StringToExpect); err != nil {
-			return err
-		}
-	} else {
-		if err := d.Set("type", "ping"); err != nil {
-			return err
+// updateResourceFromHTTPDetails sets the HTTP specific attributes of a check
+// resource.
+func updateResourceFromHTTPDetails(d *schema.ResourceData, http *pingdom.CheckResponseHTTPDetails) error {
+	if err := d.Set("url", http.Url); err != nil {
+		return err
+	}
+	if err := d.Set("encryption", http.Encryption); err != nil {
+		return err
+	}
+	if err := d.Set("port", http.Port); err != nil {
+		return err
+	}
+	if err := d.Set("username", http.Username); err != nil {
+		return err
+	}
+	if err := d.Set("password", http.Password); err != nil {
+		return err
+	}
+	if err := d.Set("shouldcontain", http.ShouldContain); err != nil {
+		return err
+	}
+	if err := d.Set("shouldnotcontain", http.ShouldNotContain); err != nil {
+		return err
+	}
+	if err := d.Set("postdata", http.PostData); err != nil {
+		return err
+	}
+
+	if v, ok := http.RequestHeaders["User-Agent"]; ok {
+		if strings.HasPrefix(v, "Pingdom.com_bot_version_") {
+			delete(http.RequestHeaders, "User-Agent")
 		}
 	}
+	if err := d.Set("requestheaders", http.RequestHeaders); err != nil {
+		return err
+	}
+	if err := d.Set("verify_certificate", http.VerifyCertificate); err != nil {
+		return err
+	}
+	if err := d.Set("ssl_down_days_before", http.SSLDownDaysBefore); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// updateResourceFromTCPDetails sets the TCP specific attributes of a check
+// resource.
+func updateResourceFromTCPDetails(d *schema.ResourceData, tcp *pingdom.CheckResponseTCPDetails) error {
+	if err := d.Set("port", tcp.Port); err != nil {
+		return err
+	}
+	if err := d.Set("stringtosend", tcp.StringToSend); err != nil {
+		return err
+	}
+	if err := d.Set("stringtoexpect", tcp.StringToExpect); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -0,0 +1,88 @@
+package pingdom
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// resourcePingdomCheckV0 is the schema of pingdom_check before tags became a
+// set. Only the attribute types matter here; it is used to decode old state.
+func resourcePingdomCheckV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":                     {Type: schema.TypeString, Required: true},
+			"host":                     {Type: schema.TypeString, Required: true},
+			"type":                     {Type: schema.TypeString, Required: true},
+			"paused":                   {Type: schema.TypeBool, Optional: true},
+			"responsetime_threshold":   {Type: schema.TypeInt, Optional: true, Computed: true},
+			"resolution":               {Type: schema.TypeInt, Required: true},
+			"sendnotificationwhendown": {Type: schema.TypeInt, Optional: true, Computed: true},
+			"notifyagainevery":         {Type: schema.TypeInt, Optional: true},
+			"notifywhenbackup":         {Type: schema.TypeBool, Optional: true, Computed: true},
+			"integrationids":           {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeInt}},
+			"encryption":               {Type: schema.TypeBool, Optional: true},
+			"url":                      {Type: schema.TypeString, Optional: true},
+			"port":                     {Type: schema.TypeInt, Optional: true, Computed: true},
+			"username":                 {Type: schema.TypeString, Optional: true},
+			"password":                 {Type: schema.TypeString, Optional: true},
+			"shouldcontain":            {Type: schema.TypeString, Optional: true},
+			"shouldnotcontain":         {Type: schema.TypeString, Optional: true},
+			"postdata":                 {Type: schema.TypeString, Optional: true},
+			"requestheaders":           {Type: schema.TypeMap, Optional: true},
+			"tags":                     {Type: schema.TypeString, Optional: true},
+			"probefilters":             {Type: schema.TypeString, Optional: true},
+			"userids":                  {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeInt}},
+			"teamids":                  {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeInt}},
+			"stringtosend":             {Type: schema.TypeString, Optional: true},
+			"stringtoexpect":           {Type: schema.TypeString, Optional: true},
+			"nameserver":               {Type: schema.TypeString, Optional: true},
+			"expectedip":               {Type: schema.TypeString, Optional: true},
+			"additionalurls":           {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		},
+	}
+}
+
+// resourcePingdomCheckStateUpgradeV0 converts the comma separated tags string
+// into a list, dropping whitespace, empty elements and duplicates.
+func resourcePingdomCheckStateUpgradeV0(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	tags := []interface{}{}
+	seen := map[string]bool{}
+	if v, ok := rawState["tags"].(string); ok {
+		for _, tag := range strings.Split(v, ",") {
+			tag = strings.TrimSpace(tag)
+			if tag == "" || seen[tag] {
+				continue
+			}
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+	rawState["tags"] = tags
+
+	return rawState, nil
+}
+
+// resourcePingdomCheckV1 is the schema of pingdom_check before probefilters
+// became a set.
+func resourcePingdomCheckV1() *schema.Resource {
+	r := resourcePingdomCheckV0()
+	r.Schema["tags"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	return r
+}
+
+// resourcePingdomCheckStateUpgradeV1 converts the single probefilters string
+// into a list holding that filter.
+func resourcePingdomCheckStateUpgradeV1(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	probefilters := []interface{}{}
+	if v, ok := rawState["probefilters"].(string); ok && v != "" {
+		probefilters = append(probefilters, normaliseProbeFilter(v))
+	}
+	rawState["probefilters"] = probefilters
+
+	return rawState, nil
+}
@@ -0,0 +1,170 @@
+package pingdom
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestValidateCheckHost(t *testing.T) {
+	cases := []struct {
+		host  string
+		valid bool
+	}{
+		{"example.com", true},
+		{"10.0.0.1", true},
+		{"https://example.com", false},
+		{"example.com/health", false},
+		{"example.com?foo=bar", false},
+	}
+
+	for _, tc := range cases {
+		_, errs := validateCheckHost(tc.host, "host")
+		if valid := len(errs) == 0; valid != tc.valid {
+			t.Errorf("validateCheckHost(%q): expected valid=%v, got errors %v", tc.host, tc.valid, errs)
+		}
+	}
+}
+
+func TestResourcePingdomCheckStateUpgradeV0(t *testing.T) {
+	cases := []struct {
+		tags     interface{}
+		expected []interface{}
+	}{
+		{"", []interface{}{}},
+		{nil, []interface{}{}},
+		{"a", []interface{}{"a"}},
+		{"b,a", []interface{}{"b", "a"}},
+		{"a, b,,a ", []interface{}{"a", "b"}},
+	}
+
+	for _, tc := range cases {
+		raw := map[string]interface{}{"name": "check", "tags": tc.tags}
+		actual, err := resourcePingdomCheckStateUpgradeV0(raw, nil)
+		if err != nil {
+			t.Fatalf("unexpected error upgrading %q: %s", tc.tags, err)
+		}
+		if !reflect.DeepEqual(actual["tags"], tc.expected) {
+			t.Errorf("upgrading %q: expected %#v, got %#v", tc.tags, tc.expected, actual["tags"])
+		}
+		if actual["name"] != "check" {
+			t.Errorf("upgrading %q changed unrelated attributes: %#v", tc.tags, actual)
+		}
+	}
+}
+
+func TestResourcePingdomCheckStateUpgradeV1(t *testing.T) {
+	cases := []struct {
+		probefilters interface{}
+		expected     []interface{}
+	}{
+		{"", []interface{}{}},
+		{nil, []interface{}{}},
+		{"region:NA", []interface{}{"region:NA"}},
+		{"region: EU", []interface{}{"region:EU"}},
+	}
+
+	for _, tc := range cases {
+		raw := map[string]interface{}{"probefilters": tc.probefilters}
+		actual, err := resourcePingdomCheckStateUpgradeV1(raw, nil)
+		if err != nil {
+			t.Fatalf("unexpected error upgrading %q: %s", tc.probefilters, err)
+		}
+		if !reflect.DeepEqual(actual["probefilters"], tc.expected) {
+			t.Errorf("upgrading %q: expected %#v, got %#v", tc.probefilters, tc.expected, actual["probefilters"])
+		}
+	}
+}
+
+func TestParseTargetURL(t *testing.T) {
+	cases := []struct {
+		raw      string
+		expected *targetURL
+		str      string
+	}{
+		{"https://example.com", &targetURL{"example.com", "/", 0, true}, "https://example.com/"},
+		{"http://example.com/health?full=1", &targetURL{"example.com", "/health?full=1", 0, false}, "http://example.com/health?full=1"},
+		{"https://example.com:8443/health", &targetURL{"example.com", "/health", 8443, true}, "https://example.com:8443/health"},
+		{"http://example.com:80/", &targetURL{"example.com", "/", 80, false}, "http://example.com/"},
+	}
+
+	for _, tc := range cases {
+		actual, err := parseTargetURL(tc.raw)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %s", tc.raw, err)
+		}
+		if !reflect.DeepEqual(actual, tc.expected) {
+			t.Errorf("parsing %q: expected %#v, got %#v", tc.raw, tc.expected, actual)
+		}
+		if str := actual.String(); str != tc.str {
+			t.Errorf("formatting %q: expected %q, got %q", tc.raw, tc.str, str)
+		}
+	}
+
+	for _, raw := range []string{"example.com", "ftp://example.com", "https:///health"} {
+		if _, err := parseTargetURL(raw); err == nil {
+			t.Errorf("expected an error parsing %q", raw)
+		}
+	}
+}
+
+func TestTargetURLEquals(t *testing.T) {
+	configured := &targetURL{"example.com", "/", 0, true}
+	if !configured.equals(&targetURL{"example.com", "/", 443, true}) {
+		t.Error("expected an unset port to equal the default https port")
+	}
+	if configured.equals(&targetURL{"example.com", "/", 8443, true}) {
+		t.Error("expected a different port not to be equal")
+	}
+	if configured.equals(&targetURL{"example.com", "/", 0, false}) {
+		t.Error("expected a different scheme not to be equal")
+	}
+}
+
+func TestResourcePingdomCheckCustomizeDiff(t *testing.T) {
+	cases := []struct {
+		config map[string]interface{}
+		valid  bool
+	}{
+		{map[string]interface{}{"name": "a", "type": "ping", "resolution": 5}, false},
+		{map[string]interface{}{"name": "a", "type": "ping", "resolution": 5, "host": "example.com"}, true},
+		{map[string]interface{}{"name": "a", "type": "http", "resolution": 5, "target_url": "https://example.com/"}, true},
+		{map[string]interface{}{"name": "a", "type": "ping", "resolution": 5, "target_url": "https://example.com/"}, false},
+	}
+
+	for _, tc := range cases {
+		_, err := resourcePingdomCheck().Diff(nil, terraform.NewResourceConfigRaw(tc.config), nil)
+		if valid := err == nil; valid != tc.valid {
+			t.Errorf("Diff(%v): expected valid=%v, got error %v", tc.config, tc.valid, err)
+		}
+	}
+}
+
+func TestCheckForTypeTargetURLPort(t *testing.T) {
+	cases := []struct {
+		targetURL string
+		port      string
+	}{
+		{"https://example.com/", "443"},
+		{"http://example.com/", "80"},
+		{"https://example.com:8443/", "8443"},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourcePingdomCheck().Schema, map[string]interface{}{
+			"name":       "a",
+			"type":       "http",
+			"resolution": 5,
+			"target_url": tc.targetURL,
+		})
+		check, err := checkForType(d, "http")
+		if err != nil {
+			t.Fatalf("checkForType(%q): %s", tc.targetURL, err)
+		}
+		if port := check.PutParams()["port"]; port != tc.port {
+			t.Errorf("checkForType(%q): expected port %s, got %q", tc.targetURL, tc.port, port)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package pingdom
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+func resourcePingdomHTTPCheck() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePingdomHTTPCheckCreate,
+		Read:   resourcePingdomHTTPCheckRead,
+		Update: resourcePingdomHTTPCheckUpdate,
+		Delete: resourcePingdomCheckDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: reserveCheckCreditForDiff,
+
+		Schema: mergeSchemas(commonCheckSchema(), map[string]*schema.Schema{
+			"responsetime_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     false,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 30000),
+			},
+
+			"encryption": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: false,
+			},
+
+			"url": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+				Default:  "/",
+			},
+
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     false,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+
+			"username": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  false,
+				Sensitive: true,
+			},
+
+			"shouldcontain": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      false,
+				ConflictsWith: []string{"shouldnotcontain"},
+			},
+
+			"shouldnotcontain": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      false,
+				ConflictsWith: []string{"shouldcontain"},
+			},
+
+			"postdata": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+
+			"requestheaders": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: false,
+			},
+
+			"verify_certificate": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: false,
+				Computed: true,
+			},
+
+			"ssl_down_days_before": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     false,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		}),
+	}
+}
+
+func resourcePingdomHTTPCheckCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	check, err := checkForType(d, "http")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] HTTP check create configuration: %#v, %#v", d.Get("name"), d.Get("host"))
+
+	ck, err := client.Checks.Create(check)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(ck.ID))
+
+	return resourcePingdomHTTPCheckRead(d, meta)
+}
+
+func resourcePingdomHTTPCheckRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	ck, details, err := readCheckForResource(d, client)
+	if err != nil {
+		return err
+	}
+	if ck == nil {
+		return nil
+	}
+
+	if ck.Type.HTTP == nil {
+		return fmt.Errorf("check %d is a %s check, not an http check", ck.ID, ck.Type.Name)
+	}
+
+	if err := updateResourceFromCheckResponse(d, ck, details); err != nil {
+		return err
+	}
+
+	if err := d.Set("responsetime_threshold", ck.ResponseTimeThreshold); err != nil {
+		return err
+	}
+
+	return updateResourceFromHTTPDetails(d, ck.Type.HTTP)
+}
+
+func resourcePingdomHTTPCheckUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving id for resource: %s", err)
+	}
+
+	check, err := checkForType(d, "http")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] HTTP check update configuration: %#v, %#v", d.Get("name"), d.Get("host"))
+
+	if _, err = client.Checks.Update(id, check); err != nil {
+		return fmt.Errorf("Error updating check: %s", err)
+	}
+
+	return resourcePingdomHTTPCheckRead(d, meta)
+}
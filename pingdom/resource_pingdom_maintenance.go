@@ -0,0 +1,266 @@
+package pingdom
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+func resourcePingdomMaintenance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePingdomMaintenanceCreate,
+		Read:   resourcePingdomMaintenanceRead,
+		Update: resourcePingdomMaintenanceUpdate,
+		Delete: resourcePingdomMaintenanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: false,
+			},
+			"from": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         false,
+				ValidateFunc:     validation.ValidateRFC3339TimeString,
+				DiffSuppressFunc: suppressEquivalentTimes,
+			},
+			"to": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         false,
+				ValidateFunc:     validation.ValidateRFC3339TimeString,
+				DiffSuppressFunc: suppressEquivalentTimes,
+			},
+			"recurrence_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     false,
+				Default:      "none",
+				ValidateFunc: validation.StringInSlice([]string{"none", "day", "week", "month"}, false),
+			},
+			"repeat_every": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: false,
+			},
+			"effective_to": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         false,
+				ValidateFunc:     validation.ValidateRFC3339TimeString,
+				DiffSuppressFunc: suppressEquivalentTimes,
+			},
+			"uptime_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: false,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"tms_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: false,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+// suppressEquivalentTimes suppresses diffs between RFC3339 timestamps that
+// denote the same instant, as the API returns times in UTC.
+func suppressEquivalentTimes(k, old, new string, d *schema.ResourceData) bool {
+	o, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	n, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return o.Equal(n)
+}
+
+// unixToRFC3339 formats a Unix timestamp returned by the API as RFC3339.
+func unixToRFC3339(t int64) string {
+	return time.Unix(t, 0).UTC().Format(time.RFC3339)
+}
+
+// sortedIntSetString returns the integers of a set as a sorted, comma
+// separated string.
+func sortedIntSetString(v interface{}) string {
+	intSlice := intSetToSlice(v)
+	sort.Ints(intSlice)
+	return intListToCDString(intSlice)
+}
+
+func maintenanceForResource(d *schema.ResourceData) (*pingdom.MaintenanceWindow, error) {
+	maintenance := pingdom.MaintenanceWindow{}
+
+	// required
+	if v, ok := d.GetOk("description"); ok {
+		maintenance.Description = v.(string)
+	}
+
+	if v, ok := d.GetOk("from"); ok {
+		from, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing from: %s", err)
+		}
+		maintenance.From = from.Unix()
+	}
+
+	if v, ok := d.GetOk("to"); ok {
+		to, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing to: %s", err)
+		}
+		maintenance.To = to.Unix()
+	}
+
+	if v, ok := d.GetOk("recurrence_type"); ok {
+		maintenance.RecurrenceType = v.(string)
+	}
+
+	if v, ok := d.GetOk("repeat_every"); ok {
+		maintenance.RepeatEvery = v.(int)
+	}
+
+	if v, ok := d.GetOk("effective_to"); ok {
+		effectiveTo, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing effective_to: %s", err)
+		}
+		maintenance.EffectiveTo = int(effectiveTo.Unix())
+	}
+
+	if v, ok := d.GetOk("uptime_ids"); ok {
+		maintenance.UptimeIDs = sortedIntSetString(v)
+	}
+
+	if v, ok := d.GetOk("tms_ids"); ok {
+		maintenance.TmsIDs = sortedIntSetString(v)
+	}
+
+	return &maintenance, nil
+}
+
+func resourcePingdomMaintenanceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	maintenance, err := maintenanceForResource(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Maintenance create configuration: %#v", d.Get("description"))
+	result, err := client.Maintenances.Create(maintenance)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(result.ID))
+	return resourcePingdomMaintenanceRead(d, meta)
+}
+
+func resourcePingdomMaintenanceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving id for resource: %s", err)
+	}
+	maintenance, err := client.Maintenances.Read(id)
+	if isNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error retrieving maintenance: %s", err)
+	}
+
+	if err := d.Set("description", maintenance.Description); err != nil {
+		return err
+	}
+
+	if err := d.Set("from", unixToRFC3339(maintenance.From)); err != nil {
+		return err
+	}
+
+	if err := d.Set("to", unixToRFC3339(maintenance.To)); err != nil {
+		return err
+	}
+
+	if err := d.Set("recurrence_type", maintenance.RecurrenceType); err != nil {
+		return err
+	}
+
+	if err := d.Set("repeat_every", maintenance.RepeatEvery); err != nil {
+		return err
+	}
+
+	// The API defaults the recurrence end to the end of the window; keep
+	// effective_to unset in that case unless it was set explicitly.
+	effectiveTo := ""
+	if maintenance.EffectiveTo != 0 && (maintenance.EffectiveTo != maintenance.To || d.Get("effective_to").(string) != "") {
+		effectiveTo = unixToRFC3339(maintenance.EffectiveTo)
+	}
+	if err := d.Set("effective_to", effectiveTo); err != nil {
+		return err
+	}
+
+	if err := d.Set("uptime_ids", maintenance.Checks.Uptime); err != nil {
+		return err
+	}
+
+	if err := d.Set("tms_ids", maintenance.Checks.Tms); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourcePingdomMaintenanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving id for resource: %s", err)
+	}
+
+	maintenance, err := maintenanceForResource(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Maintenance update configuration: %#v", d.Get("description"))
+
+	if err = updateMaintenance(client, id, maintenance); err != nil {
+		return fmt.Errorf("Error updating maintenance: %s", err)
+	}
+
+	return resourcePingdomMaintenanceRead(d, meta)
+}
+
+func resourcePingdomMaintenanceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving id for resource: %s", err)
+	}
+	if _, err = client.Maintenances.Delete(id); err != nil {
+		return fmt.Errorf("Error deleting maintenance: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package pingdom
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+func resourcePingdomMaintenanceOccurrence() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePingdomMaintenanceOccurrenceCreate,
+		Read:   resourcePingdomMaintenanceOccurrenceRead,
+		Update: resourcePingdomMaintenanceOccurrenceUpdate,
+		Delete: resourcePingdomMaintenanceOccurrenceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePingdomMaintenanceOccurrenceImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"occurrence_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"maintenance_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"from": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         false,
+				Computed:         true,
+				ValidateFunc:     validation.ValidateRFC3339TimeString,
+				DiffSuppressFunc: suppressEquivalentTimes,
+			},
+			"to": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         false,
+				Computed:         true,
+				ValidateFunc:     validation.ValidateRFC3339TimeString,
+				DiffSuppressFunc: suppressEquivalentTimes,
+			},
+		},
+	}
+}
+
+// maintenanceOccurrenceTimes returns the configured start and end of the
+// occurrence, falling back to the current ones for any left unset.
+func maintenanceOccurrenceTimes(d *schema.ResourceData, occurrence *maintenanceOccurrence) (int64, int64, error) {
+	from, to := occurrence.From, occurrence.To
+
+	if v, ok := d.GetOk("from"); ok {
+		t, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return 0, 0, fmt.Errorf("Error parsing from: %s", err)
+		}
+		from = t.Unix()
+	}
+
+	if v, ok := d.GetOk("to"); ok {
+		t, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return 0, 0, fmt.Errorf("Error parsing to: %s", err)
+		}
+		to = t.Unix()
+	}
+
+	return from, to, nil
+}
+
+func updateMaintenanceOccurrenceForResource(d *schema.ResourceData, client *pingdom.Client, id int) error {
+	occurrence, err := readMaintenanceOccurrence(client, id)
+	if err != nil {
+		return fmt.Errorf("Error retrieving maintenance occurrence: %s", err)
+	}
+
+	from, to, err := maintenanceOccurrenceTimes(d, occurrence)
+	if err != nil {
+		return err
+	}
+	if from == occurrence.From && to == occurrence.To {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Maintenance occurrence update configuration: %d, %d-%d", id, from, to)
+
+	if err := updateMaintenanceOccurrence(client, id, from, to); err != nil {
+		return fmt.Errorf("Error updating maintenance occurrence: %s", err)
+	}
+	return nil
+}
+
+func resourcePingdomMaintenanceOccurrenceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	id := d.Get("occurrence_id").(int)
+	if err := updateMaintenanceOccurrenceForResource(d, client, id); err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(id))
+	return resourcePingdomMaintenanceOccurrenceRead(d, meta)
+}
+
+func resourcePingdomMaintenanceOccurrenceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving id for resource: %s", err)
+	}
+	occurrence, err := readMaintenanceOccurrence(client, id)
+	if isNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error retrieving maintenance occurrence: %s", err)
+	}
+
+	if err := d.Set("occurrence_id", occurrence.ID); err != nil {
+		return err
+	}
+
+	if err := d.Set("maintenance_id", occurrence.MaintenanceID); err != nil {
+		return err
+	}
+
+	if err := d.Set("from", unixToRFC3339(occurrence.From)); err != nil {
+		return err
+	}
+
+	if err := d.Set("to", unixToRFC3339(occurrence.To)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourcePingdomMaintenanceOccurrenceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving id for resource: %s", err)
+	}
+
+	if err := updateMaintenanceOccurrenceForResource(d, client, id); err != nil {
+		return err
+	}
+
+	return resourcePingdomMaintenanceOccurrenceRead(d, meta)
+}
+
+func resourcePingdomMaintenanceOccurrenceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving id for resource: %s", err)
+	}
+
+	log.Printf("[INFO] Deleting maintenance occurrence: %v", id)
+
+	if err = deleteMaintenanceOccurrence(client, id); err != nil && !isNotFound(err) {
+		return fmt.Errorf("Error deleting maintenance occurrence: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePingdomMaintenanceOccurrenceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing occurrence id '%s': %s", d.Id(), err)
+	}
+	if err := d.Set("occurrence_id", id); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
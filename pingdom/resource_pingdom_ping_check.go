@@ -0,0 +1,99 @@
+package pingdom
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+func resourcePingdomPingCheck() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePingdomPingCheckCreate,
+		Read:   resourcePingdomPingCheckRead,
+		Update: resourcePingdomPingCheckUpdate,
+		Delete: resourcePingdomCheckDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: reserveCheckCreditForDiff,
+
+		Schema: mergeSchemas(commonCheckSchema(), map[string]*schema.Schema{
+			"responsetime_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     false,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 30000),
+			},
+		}),
+	}
+}
+
+func resourcePingdomPingCheckCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	check, err := checkForType(d, "ping")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Ping check create configuration: %#v, %#v", d.Get("name"), d.Get("host"))
+
+	ck, err := client.Checks.Create(check)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(ck.ID))
+
+	return resourcePingdomPingCheckRead(d, meta)
+}
+
+func resourcePingdomPingCheckRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	ck, details, err := readCheckForResource(d, client)
+	if err != nil {
+		return err
+	}
+	if ck == nil {
+		return nil
+	}
+
+	if ck.Type.Name != "ping" {
+		return fmt.Errorf("check %d is a %s check, not a ping check", ck.ID, ck.Type.Name)
+	}
+
+	if err := updateResourceFromCheckResponse(d, ck, details); err != nil {
+		return err
+	}
+
+	return d.Set("responsetime_threshold", ck.ResponseTimeThreshold)
+}
+
+func resourcePingdomPingCheckUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving id for resource: %s", err)
+	}
+
+	check, err := checkForType(d, "ping")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Ping check update configuration: %#v, %#v", d.Get("name"), d.Get("host"))
+
+	if _, err = client.Checks.Update(id, check); err != nil {
+		return fmt.Errorf("Error updating check: %s", err)
+	}
+
+	return resourcePingdomPingCheckRead(d, meta)
+}
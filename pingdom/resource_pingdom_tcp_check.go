@@ -0,0 +1,110 @@
+package pingdom
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+func resourcePingdomTCPCheck() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePingdomTCPCheckCreate,
+		Read:   resourcePingdomTCPCheckRead,
+		Update: resourcePingdomTCPCheckUpdate,
+		Delete: resourcePingdomCheckDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: reserveCheckCreditForDiff,
+
+		Schema: mergeSchemas(commonCheckSchema(), map[string]*schema.Schema{
+			"port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     false,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+
+			"stringtosend": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+
+			"stringtoexpect": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+		}),
+	}
+}
+
+func resourcePingdomTCPCheckCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	check, err := checkForType(d, "tcp")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] TCP check create configuration: %#v, %#v", d.Get("name"), d.Get("host"))
+
+	ck, err := client.Checks.Create(check)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(ck.ID))
+
+	return resourcePingdomTCPCheckRead(d, meta)
+}
+
+func resourcePingdomTCPCheckRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	ck, details, err := readCheckForResource(d, client)
+	if err != nil {
+		return err
+	}
+	if ck == nil {
+		return nil
+	}
+
+	if ck.Type.TCP == nil {
+		return fmt.Errorf("check %d is a %s check, not a tcp check", ck.ID, ck.Type.Name)
+	}
+
+	if err := updateResourceFromCheckResponse(d, ck, details); err != nil {
+		return err
+	}
+
+	return updateResourceFromTCPDetails(d, ck.Type.TCP)
+}
+
+func resourcePingdomTCPCheckUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving id for resource: %s", err)
+	}
+
+	check, err := checkForType(d, "tcp")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] TCP check update configuration: %#v, %#v", d.Get("name"), d.Get("host"))
+
+	if _, err = client.Checks.Update(id, check); err != nil {
+		return fmt.Errorf("Error updating check: %s", err)
+	}
+
+	return resourcePingdomTCPCheckRead(d, meta)
+}
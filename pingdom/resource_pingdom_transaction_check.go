@@ -0,0 +1,423 @@
+package pingdom
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+func resourcePingdomTransactionCheck() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePingdomTransactionCheckCreate,
+		Read:   resourcePingdomTransactionCheckRead,
+		Update: resourcePingdomTransactionCheckUpdate,
+		Delete: resourcePingdomTransactionCheckDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: false,
+			},
+
+			"step": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: false,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fn": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"args": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: false,
+				Default:  true,
+			},
+
+			"region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     false,
+				Default:      "us-east",
+				ValidateFunc: validation.StringInSlice([]string{"us-east", "us-west", "eu", "au"}, false),
+			},
+
+			"interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     false,
+				Default:      10,
+				ValidateFunc: validation.IntInSlice([]int{5, 10, 20, 60, 720, 1440}),
+			},
+
+			"severity_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     false,
+				Default:      "high",
+				ValidateFunc: validation.StringInSlice([]string{"high", "low"}, false),
+			},
+
+			"send_notification_when_down": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: false,
+				Computed: true,
+			},
+
+			"custom_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: false,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"contact_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: false,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+
+			"team_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: false,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+
+			"integration_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: false,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+
+			// metadata is computed as the API fills in a default browser.
+			"metadata": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: false,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"width": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"height": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"disable_websecurity": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"http_authentication": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"username": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"password": {
+										Type:      schema.TypeString,
+										Required:  true,
+										Sensitive: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func intSetToSlice(v interface{}) []int {
+	ints := []int{}
+	for _, i := range v.(*schema.Set).List() {
+		ints = append(ints, i.(int))
+	}
+	return ints
+}
+
+func tmsCheckForResource(d *schema.ResourceData) (*tmsCheck, error) {
+	check := tmsCheck{
+		ContactIDs:     intSetToSlice(d.Get("contact_ids")),
+		TeamIDs:        intSetToSlice(d.Get("team_ids")),
+		IntegrationIDs: intSetToSlice(d.Get("integration_ids")),
+		Tags:           []string{},
+	}
+
+	// required
+	if v, ok := d.GetOk("name"); ok {
+		check.Name = v.(string)
+	}
+
+	for _, raw := range d.Get("step").([]interface{}) {
+		input := raw.(map[string]interface{})
+		step := tmsStep{
+			Fn:   input["fn"].(string),
+			Args: input["args"].(map[string]interface{}),
+		}
+		check.Steps = append(check.Steps, step)
+	}
+
+	check.Active = d.Get("active").(bool)
+
+	if v, ok := d.GetOk("region"); ok {
+		check.Region = v.(string)
+	}
+
+	if v, ok := d.GetOk("interval"); ok {
+		check.Interval = v.(int)
+	}
+
+	if v, ok := d.GetOk("severity_level"); ok {
+		check.SeverityLevel = v.(string)
+	}
+
+	if v, ok := d.GetOk("send_notification_when_down"); ok {
+		check.SendNotificationWhenDown = v.(int)
+	}
+
+	if v, ok := d.GetOk("custom_message"); ok {
+		check.CustomMessage = v.(string)
+	}
+
+	for _, tag := range d.Get("tags").(*schema.Set).List() {
+		check.Tags = append(check.Tags, tag.(string))
+	}
+
+	// An empty metadata block is read as a nil element, leaving the API
+	// defaults in place.
+	if v, ok := d.GetOk("metadata"); ok {
+		if input, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			metadata := tmsMetadata{
+				Width:              input["width"].(int),
+				Height:             input["height"].(int),
+				DisableWebSecurity: input["disable_websecurity"].(bool),
+			}
+			if auths := input["http_authentication"].([]interface{}); len(auths) > 0 {
+				metadata.Authentications = &tmsAuthentications{}
+				for _, raw := range auths {
+					auth := raw.(map[string]interface{})
+					metadata.Authentications.HTTPAuthentications = append(metadata.Authentications.HTTPAuthentications, tmsHTTPAuthentication{
+						Host:     auth["host"].(string),
+						Username: auth["username"].(string),
+						Password: auth["password"].(string),
+					})
+				}
+			}
+			check.Metadata = &metadata
+		}
+	}
+
+	if len(check.Steps) == 0 {
+		return nil, fmt.Errorf("a transaction check must have at least one step")
+	}
+
+	return &check, nil
+}
+
+func updateResourceFromTMSCheckResponse(d *schema.ResourceData, ck *tmsCheck) error {
+	if err := d.Set("name", ck.Name); err != nil {
+		return err
+	}
+
+	steps := []map[string]interface{}{}
+	for _, step := range ck.Steps {
+		args := map[string]string{}
+		for k, v := range step.Args {
+			args[k] = fmt.Sprintf("%v", v)
+		}
+		steps = append(steps, map[string]interface{}{
+			"fn":   step.Fn,
+			"args": args,
+		})
+	}
+	if err := d.Set("step", steps); err != nil {
+		return err
+	}
+
+	if err := d.Set("active", ck.Active); err != nil {
+		return err
+	}
+
+	if err := d.Set("region", ck.Region); err != nil {
+		return err
+	}
+
+	if err := d.Set("interval", ck.Interval); err != nil {
+		return err
+	}
+
+	if err := d.Set("severity_level", ck.SeverityLevel); err != nil {
+		return err
+	}
+
+	if err := d.Set("send_notification_when_down", ck.SendNotificationWhenDown); err != nil {
+		return err
+	}
+
+	if err := d.Set("custom_message", ck.CustomMessage); err != nil {
+		return err
+	}
+
+	if err := d.Set("tags", ck.Tags); err != nil {
+		return err
+	}
+
+	if err := d.Set("contact_ids", ck.ContactIDs); err != nil {
+		return err
+	}
+
+	if err := d.Set("team_ids", ck.TeamIDs); err != nil {
+		return err
+	}
+
+	if err := d.Set("integration_ids", ck.IntegrationIDs); err != nil {
+		return err
+	}
+
+	metadata := []map[string]interface{}{}
+	if m := ck.Metadata; m != nil {
+		auths := []map[string]interface{}{}
+		if m.Authentications != nil {
+			for _, auth := range m.Authentications.HTTPAuthentications {
+				auths = append(auths, map[string]interface{}{
+					"host":     auth.Host,
+					"username": auth.Username,
+					"password": auth.Password,
+				})
+			}
+		}
+		metadata = append(metadata, map[string]interface{}{
+			"width":               m.Width,
+			"height":              m.Height,
+			"disable_websecurity": m.DisableWebSecurity,
+			"http_authentication": auths,
+		})
+	}
+	if err := d.Set("metadata", metadata); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourcePingdomTransactionCheckCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	check, err := tmsCheckForResource(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Transaction check create configuration: %#v", d.Get("name"))
+
+	ck, err := createTMSCheck(client, check)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(ck.ID))
+
+	return resourcePingdomTransactionCheckRead(d, meta)
+}
+
+func resourcePingdomTransactionCheckRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving id for resource: %s", err)
+	}
+	ck, err := readTMSCheck(client, id)
+	if isNotFound(err) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error retrieving transaction check: %s", err)
+	}
+
+	return updateResourceFromTMSCheckResponse(d, ck)
+}
+
+func resourcePingdomTransactionCheckUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving id for resource: %s", err)
+	}
+
+	check, err := tmsCheckForResource(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Transaction check update configuration: %#v", d.Get("name"))
+
+	if err = updateTMSCheck(client, id, check); err != nil {
+		return fmt.Errorf("Error updating transaction check: %s", err)
+	}
+
+	return resourcePingdomTransactionCheckRead(d, meta)
+}
+
+func resourcePingdomTransactionCheckDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*pingdom.Client)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving id for resource: %s", err)
+	}
+
+	log.Printf("[INFO] Deleting transaction check: %v", id)
+
+	if err = deleteTMSCheck(client, id); err != nil {
+		return fmt.Errorf("Error deleting transaction check: %s", err)
+	}
+
+	return nil
+}
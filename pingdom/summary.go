@@ -0,0 +1,173 @@
+package pingdom
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+// summaryAverage is the uptime and average response time of a check over a
+// time range, as returned by /summary.average.
+type summaryAverage struct {
+	ResponseTime summaryAverageResponseTime `json:"responsetime"`
+	Status       summaryAverageStatus       `json:"status"`
+}
+
+type summaryAverageResponseTime struct {
+	From        int64 `json:"from"`
+	To          int64 `json:"to"`
+	AvgResponse int   `json:"avgresponse"`
+}
+
+type summaryAverageStatus struct {
+	TotalUp      int `json:"totalup"`
+	TotalDown    int `json:"totaldown"`
+	TotalUnknown int `json:"totalunknown"`
+}
+
+// availability returns the share of the monitored time the check was up, as a
+// percentage. Time without results is left out.
+func (s summaryAverageStatus) availability() float64 {
+	monitored := s.TotalUp + s.TotalDown
+	if monitored == 0 {
+		return 0
+	}
+	return float64(s.TotalUp) / float64(monitored) * 100
+}
+
+type summaryAverageJSONResponse struct {
+	Summary summaryAverage `json:"summary"`
+}
+
+// summaryProbeAverage is the average response time measured by a single probe.
+type summaryProbeAverage struct {
+	ProbeID     int `json:"probeid"`
+	AvgResponse int `json:"avgresponse"`
+	N           int `json:"n"`
+}
+
+type summaryByProbeJSONResponse struct {
+	Summary struct {
+		ResponseTime struct {
+			AvgResponse []summaryProbeAverage `json:"avgresponse"`
+		} `json:"responsetime"`
+	} `json:"summary"`
+}
+
+// summaryParams returns the query parameters selecting a time range and,
+// optionally, the probes to include.
+func summaryParams(from int64, to int64, probes []int) map[string]string {
+	params := map[string]string{
+		"from": strconv.FormatInt(from, 10),
+		"to":   strconv.FormatInt(to, 10),
+	}
+	if len(probes) > 0 {
+		ids := make([]string, len(probes))
+		for i, id := range probes {
+			ids[i] = strconv.Itoa(id)
+		}
+		params["probes"] = strings.Join(ids, ",")
+	}
+	return params
+}
+
+// readSummaryAverage returns the uptime and average response time of a check
+// over all probes.
+func readSummaryAverage(client *pingdom.Client, id int, from int64, to int64) (*summaryAverage, error) {
+	params := summaryParams(from, to, nil)
+	params["includeuptime"] = "true"
+
+	m := &summaryAverageJSONResponse{}
+	if err := doRequest(client, "GET", "/summary.average/"+strconv.Itoa(id), params, m); err != nil {
+		return nil, err
+	}
+	return &m.Summary, nil
+}
+
+// readSummaryByProbe returns the average response time of a check measured by
+// each probe that ran it.
+func readSummaryByProbe(client *pingdom.Client, id int, from int64, to int64) ([]summaryProbeAverage, error) {
+	params := summaryParams(from, to, nil)
+	params["byprobe"] = "true"
+
+	m := &summaryByProbeJSONResponse{}
+	if err := doRequest(client, "GET", "/summary.average/"+strconv.Itoa(id), params, m); err != nil {
+		return nil, err
+	}
+	return m.Summary.ResponseTime.AvgResponse, nil
+}
+
+// summaryOutageState is an interval during which a check had the same status,
+// as returned by /summary.outage.
+type summaryOutageState struct {
+	Status   string `json:"status"`
+	TimeFrom int64  `json:"timefrom"`
+	TimeTo   int64  `json:"timeto"`
+}
+
+type summaryOutageJSONResponse struct {
+	Summary struct {
+		States []summaryOutageState `json:"states"`
+	} `json:"summary"`
+}
+
+// readSummaryOutage returns the up, down and unknown intervals of a check,
+// oldest first.
+func readSummaryOutage(client *pingdom.Client, id int, from int64, to int64) ([]summaryOutageState, error) {
+	params := summaryParams(from, to, nil)
+	params["order"] = "asc"
+
+	m := &summaryOutageJSONResponse{}
+	if err := doRequest(client, "GET", "/summary.outage/"+strconv.Itoa(id), params, m); err != nil {
+		return nil, err
+	}
+	return m.Summary.States, nil
+}
+
+// summaryPerformanceInterval is the performance of a check over an hour, day
+// or week, as returned by /summary.performance.
+type summaryPerformanceInterval struct {
+	StartTime   int64 `json:"starttime"`
+	AvgResponse int   `json:"avgresponse"`
+	Uptime      int   `json:"uptime"`
+	Downtime    int   `json:"downtime"`
+	Unmonitored int   `json:"unmonitored"`
+}
+
+type summaryPerformanceJSONResponse struct {
+	Summary struct {
+		Hours []summaryPerformanceInterval `json:"hours"`
+		Days  []summaryPerformanceInterval `json:"days"`
+		Weeks []summaryPerformanceInterval `json:"weeks"`
+	} `json:"summary"`
+}
+
+// readSummaryPerformance returns the performance of a check for each hour, day
+// or week of a time range, oldest first, limited to the given probes if any.
+// The API does not report uptime for a subset of probes, so uptime, downtime
+// and unmonitored time are only requested when no probes are given. The
+// request is built here as go-pingdom's SummaryPerformance ignores the time
+// range and probes.
+func readSummaryPerformance(client *pingdom.Client, id int, from int64, to int64, resolution string, probes []int) ([]summaryPerformanceInterval, error) {
+	params := summaryParams(from, to, probes)
+	params["resolution"] = resolution
+	params["order"] = "asc"
+	if len(probes) == 0 {
+		params["includeuptime"] = "true"
+	}
+
+	m := &summaryPerformanceJSONResponse{}
+	if err := doRequest(client, "GET", "/summary.performance/"+strconv.Itoa(id), params, m); err != nil {
+		return nil, err
+	}
+
+	switch resolution {
+	case "day":
+		return m.Summary.Days, nil
+	case "week":
+		return m.Summary.Weeks, nil
+	default:
+		return m.Summary.Hours, nil
+	}
+}
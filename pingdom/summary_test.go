@@ -0,0 +1,42 @@
+package pingdom
+
+import (
+	"testing"
+)
+
+func TestSummaryAverageStatusAvailability(t *testing.T) {
+	cases := []struct {
+		status   summaryAverageStatus
+		expected float64
+	}{
+		{summaryAverageStatus{}, 0},
+		{summaryAverageStatus{TotalUp: 100}, 100},
+		{summaryAverageStatus{TotalUp: 75, TotalDown: 25}, 75},
+		{summaryAverageStatus{TotalUp: 75, TotalDown: 25, TotalUnknown: 900}, 75},
+		{summaryAverageStatus{TotalDown: 10, TotalUnknown: 10}, 0},
+	}
+
+	for _, tc := range cases {
+		if actual := tc.status.availability(); actual != tc.expected {
+			t.Errorf("availability() of %+v: expected %v, got %v", tc.status, tc.expected, actual)
+		}
+	}
+}
+
+func TestWeightedAvgResponse(t *testing.T) {
+	cases := []struct {
+		averages []summaryProbeAverage
+		expected int
+	}{
+		{nil, 0},
+		{[]summaryProbeAverage{{ProbeID: 1, AvgResponse: 200}}, 0},
+		{[]summaryProbeAverage{{ProbeID: 1, AvgResponse: 200, N: 10}}, 200},
+		{[]summaryProbeAverage{{ProbeID: 1, AvgResponse: 100, N: 30}, {ProbeID: 2, AvgResponse: 500, N: 10}}, 200},
+	}
+
+	for _, tc := range cases {
+		if actual := weightedAvgResponse(tc.averages); actual != tc.expected {
+			t.Errorf("weightedAvgResponse(%+v): expected %d, got %d", tc.averages, tc.expected, actual)
+		}
+	}
+}
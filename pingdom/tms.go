@@ -0,0 +1,82 @@
+package pingdom
+
+import (
+	"strconv"
+
+	"github.com/russellcardullo/go-pingdom/pingdom"
+)
+
+// tmsCheck represents a Pingdom transaction check as sent to and returned by
+// the TMS API.
+type tmsCheck struct {
+	ID                       int          `json:"id,omitempty"`
+	Name                     string       `json:"name"`
+	Active                   bool         `json:"active"`
+	ContactIDs               []int        `json:"contact_ids"`
+	CustomMessage            string       `json:"custom_message"`
+	IntegrationIDs           []int        `json:"integration_ids"`
+	Interval                 int          `json:"interval,omitempty"`
+	Metadata                 *tmsMetadata `json:"metadata,omitempty"`
+	Region                   string       `json:"region,omitempty"`
+	SendNotificationWhenDown int          `json:"send_notification_when_down,omitempty"`
+	SeverityLevel            string       `json:"severity_level,omitempty"`
+	Steps                    []tmsStep    `json:"steps"`
+	Tags                     []string     `json:"tags"`
+	TeamIDs                  []int        `json:"team_ids"`
+	Status                   string       `json:"status,omitempty"`
+}
+
+// tmsStep is a single step of a transaction check, such as going to a URL or
+// filling in a form field.
+type tmsStep struct {
+	Fn   string                 `json:"fn"`
+	Args map[string]interface{} `json:"args"`
+}
+
+// tmsMetadata holds the browser settings of a transaction check.
+type tmsMetadata struct {
+	Width              int                 `json:"width,omitempty"`
+	Height             int                 `json:"height,omitempty"`
+	DisableWebSecurity bool                `json:"disableWebSecurity"`
+	Authentications    *tmsAuthentications `json:"authentications,omitempty"`
+}
+
+// tmsAuthentications holds the credentials a transaction check uses.
+type tmsAuthentications struct {
+	HTTPAuthentications []tmsHTTPAuthentication `json:"httpAuthentications"`
+}
+
+// tmsHTTPAuthentication is a set of HTTP basic auth credentials for a host.
+type tmsHTTPAuthentication struct {
+	Host     string `json:"host"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// createTMSCheck creates a new transaction check and returns it.
+func createTMSCheck(client *pingdom.Client, check *tmsCheck) (*tmsCheck, error) {
+	m := &tmsCheck{}
+	if err := doJSONRequest(client, "POST", "/tms/check", check, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// readTMSCheck returns the transaction check with the given ID.
+func readTMSCheck(client *pingdom.Client, id int) (*tmsCheck, error) {
+	m := &tmsCheck{}
+	if err := doRequest(client, "GET", "/tms/check/"+strconv.Itoa(id), nil, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// updateTMSCheck replaces the transaction check with the given ID.
+func updateTMSCheck(client *pingdom.Client, id int, check *tmsCheck) error {
+	return doJSONRequest(client, "PUT", "/tms/check/"+strconv.Itoa(id), check, &pingdom.PingdomResponse{})
+}
+
+// deleteTMSCheck deletes the transaction check with the given ID.
+func deleteTMSCheck(client *pingdom.Client, id int) error {
+	return doRequest(client, "DELETE", "/tms/check/"+strconv.Itoa(id), nil, &pingdom.PingdomResponse{})
+}